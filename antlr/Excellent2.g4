@@ -28,6 +28,12 @@ GT: '>';
 
 AMPERSAND: '&';
 
+ARROW: '=>';
+
+QUESTIONS: '??';
+QUESTION: '?';
+COLON: ':';
+
 TEXT: '"' (~["] | '\\"')* '"';
 INTEGER: [0-9]+;
 DECIMAL: [0-9]+ '.' [0-9]+;
@@ -36,6 +42,10 @@ TRUE: [Tt][Rr][Uu][Ee];
 FALSE: [Ff][Aa][Ll][Ss][Ee];
 NULL: [Nn][Uu][Ll][Ll];
 
+AND: [Aa][Nn][Dd];
+OR: [Oo][Rr];
+NOT: [Nn][Oo][Tt];
+
 NAME: (UnicodeLetter | '_')+ (UnicodeLetter | UnicodeDigit | '_')*;
 
 WS: [ \t\n\r]+ -> skip; // ignore whitespace
@@ -53,18 +63,24 @@ expression:
 	| expression op = (LTE | LT | GTE | GT) expression	# comparison
 	| expression op = (EQ | NEQ) expression				# equality
 	| expression AMPERSAND expression					# concatenation
+	| NOT expression									# not
+	| expression AND expression							# and
+	| expression OR expression							# or
+	| expression QUESTIONS expression					# coalesce
+	| <assoc = right> expression QUESTION expression COLON expression	# conditional
 	| TEXT												# textLiteral
 	| (INTEGER | DECIMAL)								# numberLiteral
 	| TRUE												# true
 	| FALSE												# false
-	| NULL												# null;
+	| NULL												# null
+	| LPAREN (NAME (COMMA NAME)*)? RPAREN ARROW expression	# anonFunction;
 
 // a subset of expressions which can be followed by (), [] or .
 atom:
 	atom LPAREN parameters? RPAREN	# functionCall
-	| atom DOT (NAME | INTEGER)		# dotLookup
+	| atom DOT property = (NAME | INTEGER | AND | OR | NOT)	# dotLookup
 	| atom LBRACK expression RBRACK	# arrayLookup
 	| LPAREN expression RPAREN		# parentheses
-	| NAME							# contextReference;
+	| (NAME | AND | OR)				# contextReference;
 
 parameters: expression (COMMA expression)* # functionParameters;
\ No newline at end of file
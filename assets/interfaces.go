@@ -168,7 +168,8 @@ type Label interface {
 	Name() string
 }
 
-// LocationHierarchy is a searchable hierarchy of locations.
+// LocationHierarchy is a searchable hierarchy of locations. Locations can optionally include a boundary made
+// up of polygons of [longitude, latitude] points which allows them to be found from GPS coordinates.
 //
 //   {
 //     "name": "Rwanda",
@@ -177,6 +178,7 @@ type Label interface {
 //       {
 //         "name": "Kigali City",
 //         "aliases": ["Kigali", "Kigari"],
+//         "boundary": [[[29.95, -2.1], [30.25, -2.1], [30.25, -1.8], [29.95, -1.8], [29.95, -2.1]]],
 //         "children": [
 //           {
 //             "name": "Gasabo",
@@ -209,6 +211,12 @@ type LocationHierarchy interface {
 	FindByName(name string, level envs.LocationLevel, parent *envs.Location) []*envs.Location
 }
 
+// LocationPointFinder is an optional interface for location hierarchies which have boundaries and so can find the
+// location containing a GPS point
+type LocationPointFinder interface {
+	FindByPoint(lat, lng float64, level envs.LocationLevel) *envs.Location
+}
+
 // Resthook is a set of URLs which are subscribed to the named event.
 //
 //   {
@@ -54,13 +54,13 @@ func TestGenerateDocs(t *testing.T) {
 	assert.Contains(t, completion, "root")
 
 	types := completion["types"].([]interface{})
-	assert.Equal(t, 13, len(types))
+	assert.Equal(t, 14, len(types))
 
 	root := completion["root"].([]interface{})
 	assert.Equal(t, 11, len(root))
 
 	functions := readJSONOutput(t, outputDir, "en_US", "functions.json").([]interface{})
-	assert.Equal(t, 80, len(functions))
+	assert.Equal(t, 110, len(functions))
 }
 
 func readJSONOutput(t *testing.T, file ...string) interface{} {
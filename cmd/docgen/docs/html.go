@@ -46,6 +46,10 @@ var Templates = []struct {
 	{"Assets", "assets.md", []string{"asset"}},
 }
 
+// the UUID generator used by examples, except function examples which use their own so that functions which
+// generate UUIDs don't change the UUIDs in other examples
+var exampleUUIDs uuids.Generator
+
 // ContextFunc is a function which produces values to put the template context
 type ContextFunc func(map[string][]*TaggedItem, flows.Session, flows.Session) (map[string]string, error)
 
@@ -217,7 +221,8 @@ func buildTemplateContext(items map[string][]*TaggedItem) (map[string]string, er
 	defer dates.SetNowSource(dates.DefaultNowSource)
 
 	random.SetGenerator(random.NewSeededGenerator(123456))
-	uuids.SetGenerator(uuids.NewSeededGenerator(123456))
+	exampleUUIDs = uuids.NewSeededGenerator(123456)
+	uuids.SetGenerator(exampleUUIDs)
 	dates.SetNowSource(dates.NewFixedNowSource(time.Date(2018, 4, 11, 18, 24, 30, 123456000, time.UTC)))
 
 	session, _, err := test.CreateTestSession(server.URL, envs.RedactionPolicyNone)
@@ -17,6 +17,7 @@ import (
 	"github.com/nyaruka/goflow/flows/triggers"
 	"github.com/nyaruka/goflow/utils"
 	"github.com/nyaruka/goflow/utils/jsonx"
+	"github.com/nyaruka/goflow/utils/uuids"
 
 	"github.com/pkg/errors"
 )
@@ -219,6 +220,9 @@ func renderFunctionDoc(output *strings.Builder, item *TaggedItem, session flows.
 	}
 
 	// check the examples
+	uuids.SetGenerator(uuids.NewSeededGenerator(123456))
+	defer uuids.SetGenerator(exampleUUIDs)
+
 	for _, l := range item.examples {
 		if err := checkExample(session, l); err != nil {
 			return err
@@ -0,0 +1,34 @@
+package envs
+
+// Boundary is the geographic boundary of a location. It is made up of one or more polygons, each of which is
+// a ring of [longitude, latitude] points, i.e. the same ordering as GeoJSON coordinates.
+//
+//   [[[30.05, -1.93], [30.10, -1.93], [30.10, -1.90], [30.05, -1.90], [30.05, -1.93]]]
+//
+type Boundary [][][2]float64
+
+// Contains returns whether the given point lies within any of the polygons of this boundary
+func (b Boundary) Contains(lat, lng float64) bool {
+	for _, polygon := range b {
+		if polygonContains(polygon, lat, lng) {
+			return true
+		}
+	}
+	return false
+}
+
+// checks whether a point is inside a polygon using the ray casting algorithm
+func polygonContains(polygon [][2]float64, lat, lng float64) bool {
+	inside := false
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		lngI, latI := polygon[i][0], polygon[i][1]
+		lngJ, latJ := polygon[j][0], polygon[j][1]
+
+		if (latI > lat) != (latJ > lat) && lng < (lngJ-lngI)*(lat-latI)/(latJ-latI)+lngI {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
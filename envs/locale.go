@@ -1,6 +1,20 @@
 package envs
 
-import "golang.org/x/text/language"
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
 
 // Locale is the combination of a language and country, e.g. US English, Brazilian Portuguese
 type Locale struct {
@@ -31,3 +45,139 @@ func (l Locale) ToISO639_2() string {
 	}
 	return code
 }
+
+// ToLanguageTag returns the language tag for this locale, or language.Und if it doesn't have a valid language
+func (l Locale) ToLanguageTag() language.Tag {
+	base, err := language.ParseBase(string(l.Language))
+	if err != nil {
+		return language.Und
+	}
+
+	if l.Country != NilCountry {
+		region, err := language.ParseRegion(string(l.Country))
+		if err == nil {
+			tag, _ := language.Compose(base, region)
+			return tag
+		}
+	}
+
+	tag, _ := language.Compose(base)
+	return tag
+}
+
+// PluralCategory returns the CLDR plural category (zero, one, two, few, many or other) for a number in this locale,
+// given its integer part and its visible fraction digits, e.g. 1.50 is (1, "50"). Locales without a language use
+// the English rules.
+func (l Locale) PluralCategory(integer int, fraction string) string {
+	tag := language.English
+	if l.Language != NilLanguage {
+		tag = l.ToLanguageTag()
+	}
+
+	// operands are allowed to be modulo 10,000,000 if they're too big
+	const max = 10000000
+
+	trimmed := strings.TrimRight(fraction, "0")
+	f, _ := strconv.Atoi(fraction)
+	t, _ := strconv.Atoi(trimmed)
+
+	return pluralCategoryNames[plural.Cardinal.MatchPlural(tag, integer%max, len(fraction), len(trimmed), f%max, t%max)]
+}
+
+var pluralCategoryNames = map[plural.Form]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// conjunctions used when formatting lists in different languages
+var listConjunctions = map[Language]string{
+	"deu": "und",
+	"eng": "and",
+	"fra": "et",
+	"ind": "dan",
+	"ita": "e",
+	"kin": "na",
+	"nld": "en",
+	"por": "e",
+	"rus": "и",
+	"spa": "y",
+	"swa": "na",
+	"tur": "ve",
+	"ukr": "і",
+}
+
+// ListConjunction returns the word used to join the last two items of a list in this locale, e.g. and in English.
+// Locales whose language we don't have a conjunction for use English.
+func (l Locale) ListConjunction() string {
+	if conjunction, found := listConjunctions[l.Language]; found {
+		return conjunction
+	}
+	return listConjunctions["eng"]
+}
+
+// FormatCurrency formats an amount of the currency with the given ISO 4217 code using the symbol and digit grouping
+// of this locale, e.g. $ 1,234.50 in US English. Amounts are rounded to the usual number of decimal places for the
+// currency. Locales without a language use English.
+func (l Locale) FormatCurrency(amount decimal.Decimal, code string) (string, error) {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return "", errors.Errorf("%s is not a valid currency code", code)
+	}
+
+	tag := l.ToLanguageTag()
+	if l.Language == NilLanguage {
+		tag = NewLocale("eng", l.Country).ToLanguageTag()
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+	rounded := amount.Round(int32(scale))
+
+	if rounded.Abs().GreaterThan(maxFormattableAmount) {
+		return "", errors.Errorf("%s is too large to format as currency", amount.String())
+	}
+
+	printer := message.NewPrinter(tag)
+	formatted := printer.Sprint(currency.Symbol(unit.Amount(&localizedDecimal{printer: printer, value: rounded.Abs(), scale: int32(scale)})))
+
+	if rounded.Sign() < 0 {
+		formatted = "-" + formatted
+	}
+	return formatted, nil
+}
+
+// the largest amount we can format as currency, as the whole part is formatted as an int64
+var maxFormattableAmount = decimal.New(math.MaxInt64, 0)
+
+// a non-negative decimal which formats itself with the digit grouping and decimal symbol of a locale, so that the
+// currency formatter can format amounts without us converting them to floats
+type localizedDecimal struct {
+	printer *message.Printer
+	value   decimal.Decimal
+	scale   int32
+}
+
+// Format formats this decimal, ignoring the verb and precision which the currency formatter asks for. The whole and
+// fractional parts are formatted separately as integers, which are exact, so that both use the locale's digits.
+func (d *localizedDecimal) Format(s fmt.State, verb rune) {
+	io.WriteString(s, d.printer.Sprint(number.Decimal(d.value.IntPart())))
+
+	if d.scale > 0 {
+		fraction := d.value.Sub(d.value.Truncate(0)).Shift(d.scale).IntPart()
+
+		io.WriteString(s, d.decimalSymbol())
+		io.WriteString(s, d.printer.Sprint(number.Decimal(fraction, number.MinIntegerDigits(int(d.scale)), number.NoSeparator())))
+	}
+}
+
+// gets the locale's decimal symbol by formatting 1.0 and removing the locale's digits for 1 and 0
+func (d *localizedDecimal) decimalSymbol() string {
+	oneAndZero := d.printer.Sprint(number.Decimal(1, number.Scale(1)))
+	one := d.printer.Sprint(number.Decimal(1))
+	zero := d.printer.Sprint(number.Decimal(0))
+
+	return strings.TrimSuffix(strings.TrimPrefix(oneAndZero, one), zero)
+}
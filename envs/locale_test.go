@@ -5,6 +5,7 @@ import (
 
 	"github.com/nyaruka/goflow/envs"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,3 +42,89 @@ func TestToISO639_2(t *testing.T) {
 		assert.Equal(t, tc.iso639_2, envs.NewLocale(tc.lang, tc.country).ToISO639_2())
 	}
 }
+
+func TestToLanguageTag(t *testing.T) {
+	assert.Equal(t, "und", envs.NewLocale(envs.NilLanguage, envs.NilCountry).ToLanguageTag().String())
+	assert.Equal(t, "en", envs.NewLocale(envs.Language(`eng`), envs.NilCountry).ToLanguageTag().String())
+	assert.Equal(t, "pt-BR", envs.NewLocale(envs.Language(`por`), envs.Country(`BR`)).ToLanguageTag().String())
+	assert.Equal(t, "yue", envs.NewLocale(envs.Language(`yue`), envs.NilCountry).ToLanguageTag().String())
+}
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		lang     envs.Language
+		integer  int
+		fraction string
+		category string
+	}{
+		{envs.NilLanguage, 1, ``, `one`},
+		{envs.NilLanguage, 2, ``, `other`},
+		{envs.Language(`eng`), 0, ``, `other`},
+		{envs.Language(`eng`), 1, ``, `one`},
+		{envs.Language(`eng`), 1, `0`, `other`},
+		{envs.Language(`eng`), 21, ``, `other`},
+		{envs.Language(`fra`), 0, ``, `one`},
+		{envs.Language(`fra`), 1, `5`, `one`},
+		{envs.Language(`fra`), 2, ``, `other`},
+		{envs.Language(`rus`), 1, ``, `one`},
+		{envs.Language(`rus`), 21, ``, `one`},
+		{envs.Language(`rus`), 3, ``, `few`},
+		{envs.Language(`rus`), 5, ``, `many`},
+		{envs.Language(`rus`), 1, `5`, `other`},
+		{envs.Language(`ara`), 0, ``, `zero`},
+		{envs.Language(`ara`), 2, ``, `two`},
+		{envs.Language(`jpn`), 1, ``, `other`},
+	}
+
+	for _, tc := range tests {
+		category := envs.NewLocale(tc.lang, envs.NilCountry).PluralCategory(tc.integer, tc.fraction)
+		assert.Equal(t, tc.category, category, "plural category mismatch for %s %d.%s", tc.lang, tc.integer, tc.fraction)
+	}
+}
+
+func TestListConjunction(t *testing.T) {
+	assert.Equal(t, "and", envs.NewLocale(envs.NilLanguage, envs.NilCountry).ListConjunction())
+	assert.Equal(t, "and", envs.NewLocale(envs.Language(`eng`), envs.Country(`US`)).ListConjunction())
+	assert.Equal(t, "y", envs.NewLocale(envs.Language(`spa`), envs.NilCountry).ListConjunction())
+	assert.Equal(t, "and", envs.NewLocale(envs.Language(`jpn`), envs.NilCountry).ListConjunction())
+}
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		lang      envs.Language
+		country   envs.Country
+		amount    string
+		code      string
+		formatted string
+	}{
+		{envs.NilLanguage, envs.NilCountry, `1234.5`, `USD`, `$ 1,234.50`},
+		{envs.NilLanguage, envs.Country(`RW`), `1234.5`, `USD`, `US$ 1,234.50`},
+		{envs.Language(`eng`), envs.Country(`US`), `1234.5`, `USD`, `$ 1,234.50`},
+		{envs.Language(`eng`), envs.Country(`US`), `-1234.5`, `USD`, `-$ 1,234.50`},
+		{envs.Language(`eng`), envs.Country(`US`), `-0.001`, `USD`, `$ 0.00`},
+		{envs.Language(`eng`), envs.Country(`US`), `1234.5`, `KES`, `KES 1,234.50`},
+		{envs.Language(`eng`), envs.Country(`RW`), `1234.5`, `RWF`, `RF 1,235`},
+		{envs.Language(`eng`), envs.Country(`IN`), `1234567`, `INR`, `₹ 12,34,567.00`},
+		{envs.Language(`kin`), envs.Country(`RW`), `1234.5`, `RWF`, `RF 1.235`},
+		{envs.Language(`fra`), envs.Country(`FR`), `1234.5`, `EUR`, "€ 1\u00a0234,50"},
+		{envs.Language(`spa`), envs.Country(`EC`), `1234.5`, `USD`, `$ 1.234,50`},
+		{envs.Language(`spa`), envs.Country(`ES`), `1234.5`, `EUR`, `€ 1.234,50`},
+		{envs.Language(`por`), envs.Country(`BR`), `1234.5`, `BRL`, `R$ 1.234,50`},
+		{envs.Language(`swa`), envs.Country(`KE`), `1234.5`, `KES`, `Ksh 1,234.50`},
+		{envs.Language(`eng`), envs.Country(`US`), `12345678901234567.891`, `USD`, `$ 12,345,678,901,234,567.89`},
+		{envs.Language(`ara`), envs.NilCountry, `1234.5`, `USD`, `US$ ١٬٢٣٤٫٥٠`},
+		{envs.Language(`fas`), envs.NilCountry, `1234.05`, `USD`, `$ ۱٬۲۳۴٫۰۵`},
+	}
+
+	for _, tc := range tests {
+		formatted, err := envs.NewLocale(tc.lang, tc.country).FormatCurrency(decimal.RequireFromString(tc.amount), tc.code)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.formatted, formatted, "currency format mismatch for %s %s in %s-%s", tc.amount, tc.code, tc.lang, tc.country)
+	}
+
+	_, err := envs.NewLocale(envs.Language(`eng`), envs.NilCountry).FormatCurrency(decimal.Zero, `XYZ`)
+	assert.EqualError(t, err, "XYZ is not a valid currency code")
+
+	_, err = envs.NewLocale(envs.Language(`eng`), envs.NilCountry).FormatCurrency(decimal.RequireFromString(`1e20`), `USD`)
+	assert.EqualError(t, err, "100000000000000000000 is too large to format as currency")
+}
@@ -30,13 +30,19 @@ var locationHierarchyJSON = `
 		{
 			"name": "Kigali City",
 			"aliases": ["Kigali", "Kigari"],
+			"boundary": [[[29.95, -2.1], [30.25, -2.1], [30.25, -1.8], [29.95, -1.8], [29.95, -2.1]]],
 			"children": [
 				{
 					"name": "Gasabo",
+					"boundary": [[[30.05, -2.0], [30.25, -2.0], [30.25, -1.8], [30.05, -1.8], [30.05, -2.0]]],
 					"children": [
 						{
 							"id": "575743222",
-							"name": "Gisozi"
+							"name": "Gisozi",
+							"boundary": [
+								[[30.05, -1.93], [30.1, -1.93], [30.1, -1.9], [30.05, -1.9], [30.05, -1.93]],
+								[[30.2, -1.85], [30.25, -1.85], [30.25, -1.8], [30.2, -1.8], [30.2, -1.85]]
+							]
 						},
 						{
 							"id": "457378732",
@@ -111,4 +117,37 @@ func TestLocationHierarchy(t *testing.T) {
 	assert.Equal(t, kigali, hierarchy.FindByPath("RWANDA > KIGALI CITY"))
 	assert.Equal(t, gasabo, hierarchy.FindByPath("rwanda > kigali city > gasabo"))
 	assert.Equal(t, ndera, hierarchy.FindByPath("rwanda > kigali city > gasabo > ndera"))
+
+	gisozi := gasabo.Children()[0]
+	assert.Equal(t, 2, len(gisozi.Boundary()))
+	assert.Equal(t, 0, len(ndera.Boundary()))
+
+	assert.Equal(t, rwanda, hierarchy.FindByPoint(-1.9167, 30.0667, envs.LocationLevel(0)))
+	assert.Equal(t, kigali, hierarchy.FindByPoint(-1.9167, 30.0667, envs.LocationLevel(1)))
+	assert.Equal(t, gasabo, hierarchy.FindByPoint(-1.9167, 30.0667, envs.LocationLevel(2)))
+	assert.Equal(t, gisozi, hierarchy.FindByPoint(-1.9167, 30.0667, envs.LocationLevel(3)))
+	assert.Equal(t, gisozi, hierarchy.FindByPoint(-1.82, 30.22, envs.LocationLevel(3))) // in second polygon
+	assert.Nil(t, hierarchy.FindByPoint(-1.95, 30.0, envs.LocationLevel(2)))            // Nyarugenge has no boundary
+	assert.Nil(t, hierarchy.FindByPoint(-1.85, 30.1, envs.LocationLevel(3)))            // Ndera has no boundary
+	assert.Nil(t, hierarchy.FindByPoint(42.3601, -71.0589, envs.LocationLevel(1)))      // not in Rwanda
+	assert.Nil(t, hierarchy.FindByPoint(-1.9167, 30.0667, envs.LocationLevel(4)))       // no such level
+}
+
+func TestBoundary(t *testing.T) {
+	square := envs.Boundary{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+
+	assert.True(t, square.Contains(5, 5))
+	assert.True(t, square.Contains(0.1, 9.9))
+	assert.False(t, square.Contains(-5, 5))
+	assert.False(t, square.Contains(5, 10.1))
+
+	// an L shaped polygon which doesn't include the top right quadrant
+	lShape := envs.Boundary{{{0, 0}, {10, 0}, {10, 5}, {5, 5}, {5, 10}, {0, 10}}}
+
+	assert.True(t, lShape.Contains(2, 2))
+	assert.True(t, lShape.Contains(8, 2))
+	assert.True(t, lShape.Contains(2, 8))
+	assert.False(t, lShape.Contains(8, 8))
+
+	assert.False(t, envs.Boundary(nil).Contains(5, 5))
 }
@@ -21,6 +21,11 @@ type LocationResolver interface {
 	LookupLocation(LocationPath) *Location
 }
 
+// PointLocationResolver is an optional interface for location resolvers which can also resolve locations from GPS points
+type PointLocationResolver interface {
+	FindLocationByPoint(float64, float64, LocationLevel) *Location
+}
+
 const (
 	LocationPathSeparator = ">"
 )
@@ -68,6 +73,7 @@ type Location struct {
 	name     string
 	path     LocationPath
 	aliases  []string
+	boundary Boundary
 	parent   *Location
 	children []*Location
 }
@@ -89,6 +95,9 @@ func (l *Location) Path() LocationPath { return l.path }
 // Aliases gets the aliases of this location
 func (l *Location) Aliases() []string { return l.aliases }
 
+// Boundary gets the geographic boundary of this location which may be empty
+func (l *Location) Boundary() Boundary { return l.boundary }
+
 // Parent gets the parent of this location
 func (l *Location) Parent() *Location { return l.parent }
 
@@ -215,6 +224,26 @@ func (h *LocationHierarchy) FindByPath(path LocationPath) *Location {
 	return h.pathLookup.lookup(path)
 }
 
+// FindByPoint looks for the location in the hierarchy with the given level whose boundary contains the
+// given point. Only locations with boundaries can be matched and the root location is always considered
+// to contain the point.
+func (h *LocationHierarchy) FindByPoint(lat, lng float64, level LocationLevel) *Location {
+	current := h.root
+
+	for current != nil && current.level < level {
+		var next *Location
+		for _, child := range current.children {
+			if child.boundary.Contains(lat, lng) {
+				next = child
+				break
+			}
+		}
+		current = next
+	}
+
+	return current
+}
+
 func (h *LocationHierarchy) UnmarshalJSON(data []byte) error {
 	var le locationEnvelope
 	if err := utils.UnmarshalAndValidate(data, &le); err != nil {
@@ -233,15 +262,17 @@ func (h *LocationHierarchy) UnmarshalJSON(data []byte) error {
 type locationEnvelope struct {
 	Name     string              `json:"name" validate:"required"`
 	Aliases  []string            `json:"aliases,omitempty"`
+	Boundary Boundary            `json:"boundary,omitempty"`
 	Children []*locationEnvelope `json:"children,omitempty"`
 }
 
 func locationFromEnvelope(envelope *locationEnvelope, currentLevel LocationLevel, parent *Location) *Location {
 	location := &Location{
-		level:   LocationLevel(currentLevel),
-		name:    envelope.Name,
-		aliases: envelope.Aliases,
-		parent:  parent,
+		level:    LocationLevel(currentLevel),
+		name:     envelope.Name,
+		aliases:  envelope.Aliases,
+		boundary: envelope.Boundary,
+		parent:   parent,
 	}
 
 	location.children = make([]*Location, len(envelope.Children))
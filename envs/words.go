@@ -0,0 +1,362 @@
+package envs
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// the largest magnitude number we can write as words
+const maxNumberInWords = 999999999999
+
+var maxDecimalInWords = decimal.New(maxNumberInWords, 0)
+
+// functions which write non-negative integers as words in different languages
+var numberSpellers = map[Language]func(int64) string{
+	"eng": spellEnglish,
+	"fra": spellFrench,
+	"por": spellPortuguese,
+	"spa": spellSpanish,
+}
+
+// words for negative numbers in different languages
+var minusWords = map[Language]string{
+	"eng": "minus",
+	"fra": "moins",
+	"por": "menos",
+	"spa": "menos",
+}
+
+// NumberToWords writes the whole part of the given number as words in this locale, e.g. 21 is twenty-one in English.
+// Locales without a language use English. An error is returned if we can't write numbers in the locale's language or
+// the number is larger than 999,999,999,999.
+func (l Locale) NumberToWords(num decimal.Decimal) (string, error) {
+	lang := l.Language
+	if lang == NilLanguage {
+		lang = "eng"
+	}
+
+	speller, supported := numberSpellers[lang]
+	if !supported {
+		return "", errors.Errorf("can't write numbers as words in language %s", lang)
+	}
+	// check the size before converting to an integer which could overflow
+	if num.Abs().GreaterThan(maxDecimalInWords) {
+		return "", errors.Errorf("can't write numbers larger than %d as words", maxNumberInWords)
+	}
+
+	n := num.IntPart()
+
+	if n < 0 {
+		return minusWords[lang] + " " + speller(-n), nil
+	}
+	return speller(n), nil
+}
+
+//------------------------------------------------------------------------------------------
+// English
+//------------------------------------------------------------------------------------------
+
+var englishUnits = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+	"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+var englishTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+var englishScales = []string{"", "thousand", "million", "billion"}
+
+func spellEnglish(n int64) string {
+	if n == 0 {
+		return englishUnits[0]
+	}
+
+	parts := make([]string, 0, 4)
+	for scale := len(englishScales) - 1; scale >= 0; scale-- {
+		group := int(n / pow1000(scale) % 1000)
+		if group == 0 {
+			continue
+		}
+		words := spellEnglishBelow1000(group)
+		if englishScales[scale] != "" {
+			words += " " + englishScales[scale]
+		}
+		parts = append(parts, words)
+	}
+	return strings.Join(parts, " ")
+}
+
+func spellEnglishBelow1000(n int) string {
+	parts := make([]string, 0, 2)
+	if n >= 100 {
+		parts = append(parts, englishUnits[n/100]+" hundred")
+		n %= 100
+	}
+	if n >= 20 {
+		words := englishTens[n/10]
+		if n%10 > 0 {
+			words += "-" + englishUnits[n%10]
+		}
+		parts = append(parts, words)
+	} else if n > 0 {
+		parts = append(parts, englishUnits[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+//------------------------------------------------------------------------------------------
+// Spanish
+//------------------------------------------------------------------------------------------
+
+var spanishUnits = []string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez",
+	"once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve", "veinte",
+	"veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+var spanishTens = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+var spanishHundreds = []string{
+	"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos",
+}
+
+func spellSpanish(n int64) string {
+	if n == 0 {
+		return spanishUnits[0]
+	}
+
+	millions, rest := n/1000000, int(n%1000000)
+	parts := make([]string, 0, 2)
+
+	if millions == 1 {
+		parts = append(parts, "un millón")
+	} else if millions > 1 {
+		parts = append(parts, spellSpanishBelowMillion(int(millions), true)+" millones")
+	}
+	if rest > 0 {
+		parts = append(parts, spellSpanishBelowMillion(rest, false))
+	}
+	return strings.Join(parts, " ")
+}
+
+// spells a number below a million, where apocope is whether a trailing uno should be shortened to un because
+// it's followed by a noun like millones
+func spellSpanishBelowMillion(n int, apocope bool) string {
+	parts := make([]string, 0, 2)
+	thousands, rest := n/1000, n%1000
+
+	if thousands == 1 {
+		parts = append(parts, "mil")
+	} else if thousands > 1 {
+		parts = append(parts, spellSpanishBelow1000(thousands, true)+" mil")
+	}
+	if rest > 0 {
+		parts = append(parts, spellSpanishBelow1000(rest, apocope))
+	}
+	return strings.Join(parts, " ")
+}
+
+func spellSpanishBelow1000(n int, apocope bool) string {
+	parts := make([]string, 0, 2)
+
+	if n == 100 {
+		return "cien"
+	}
+	if n >= 100 {
+		parts = append(parts, spanishHundreds[n/100])
+		n %= 100
+	}
+	if n >= 30 {
+		words := spanishTens[n/10]
+		if n%10 > 0 {
+			words += " y " + spanishUnits[n%10]
+		}
+		parts = append(parts, words)
+	} else if n > 0 {
+		parts = append(parts, spanishUnits[n])
+	}
+
+	words := strings.Join(parts, " ")
+	if apocope {
+		if strings.HasSuffix(words, "veintiuno") {
+			words = strings.TrimSuffix(words, "veintiuno") + "veintiún"
+		} else if strings.HasSuffix(words, "uno") {
+			words = strings.TrimSuffix(words, "o")
+		}
+	}
+	return words
+}
+
+//------------------------------------------------------------------------------------------
+// French
+//------------------------------------------------------------------------------------------
+
+var frenchUnits = []string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+	"onze", "douze", "treize", "quatorze", "quinze", "seize",
+}
+var frenchTens = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+var frenchScales = []string{"", "mille", "million", "milliard"}
+
+func spellFrench(n int64) string {
+	if n == 0 {
+		return frenchUnits[0]
+	}
+
+	parts := make([]string, 0, 4)
+	for scale := len(frenchScales) - 1; scale >= 0; scale-- {
+		group := int(n / pow1000(scale) % 1000)
+		if group == 0 {
+			continue
+		}
+
+		switch scale {
+		case 0:
+			parts = append(parts, spellFrenchBelow1000(group, true))
+		case 1:
+			// mille is invariable and isn't preceded by un
+			if group == 1 {
+				parts = append(parts, "mille")
+			} else {
+				parts = append(parts, spellFrenchBelow1000(group, false)+" mille")
+			}
+		default:
+			// million and milliard are nouns which take a plural
+			words := spellFrenchBelow1000(group, true) + " " + frenchScales[scale]
+			if group > 1 {
+				words += "s"
+			}
+			parts = append(parts, words)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// spells a number below a thousand, where final is whether it can take the plural of cents and quatre-vingts,
+// which is dropped when followed by mille
+func spellFrenchBelow1000(n int, final bool) string {
+	parts := make([]string, 0, 2)
+	hundreds, rest := n/100, n%100
+
+	if hundreds == 1 {
+		parts = append(parts, "cent")
+	} else if hundreds > 1 {
+		words := frenchUnits[hundreds] + " cent"
+		if rest == 0 && final {
+			words += "s"
+		}
+		parts = append(parts, words)
+	}
+	if rest > 0 {
+		words := spellFrenchBelow100(rest)
+		if rest == 80 && !final {
+			words = strings.TrimSuffix(words, "s")
+		}
+		parts = append(parts, words)
+	}
+	return strings.Join(parts, " ")
+}
+
+func spellFrenchBelow100(n int) string {
+	tens, units := n/10, n%10
+
+	switch {
+	case n <= 16:
+		return frenchUnits[n]
+	case n < 20:
+		return "dix-" + frenchUnits[units]
+	case tens < 7:
+		if units == 0 {
+			return frenchTens[tens]
+		} else if units == 1 {
+			return frenchTens[tens] + " et un"
+		}
+		return frenchTens[tens] + "-" + frenchUnits[units]
+	case tens == 7:
+		if units == 1 {
+			return "soixante et onze"
+		}
+		return "soixante-" + spellFrenchBelow100(10+units)
+	case n == 80:
+		return "quatre-vingts"
+	case tens == 8:
+		return "quatre-vingt-" + frenchUnits[units]
+	default:
+		return "quatre-vingt-" + spellFrenchBelow100(10+units)
+	}
+}
+
+//------------------------------------------------------------------------------------------
+// Portuguese
+//------------------------------------------------------------------------------------------
+
+var portugueseUnits = []string{
+	"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez",
+	"onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove",
+}
+var portugueseTens = []string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+var portugueseHundreds = []string{
+	"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos", "seiscentos", "setecentos", "oitocentos", "novecentos",
+}
+var portugueseScales = [][2]string{{"", ""}, {"mil", "mil"}, {"milhão", "milhões"}, {"bilhão", "bilhões"}}
+
+func spellPortuguese(n int64) string {
+	if n == 0 {
+		return portugueseUnits[0]
+	}
+
+	parts := make([]string, 0, 4)
+	for scale := len(portugueseScales) - 1; scale >= 0; scale-- {
+		group := int(n / pow1000(scale) % 1000)
+		if group == 0 {
+			continue
+		}
+
+		var words string
+		switch {
+		case scale == 0:
+			words = spellPortugueseBelow1000(group)
+		case scale == 1 && group == 1:
+			words = "mil"
+		case group == 1:
+			words = "um " + portugueseScales[scale][0]
+		default:
+			words = spellPortugueseBelow1000(group) + " " + portugueseScales[scale][1]
+		}
+
+		// the last group is joined with e if it's below a hundred or a whole number of hundreds
+		isLast := n%pow1000(scale) == 0
+		if isLast && len(parts) > 0 && (group < 100 || group%100 == 0) {
+			words = "e " + words
+		}
+		parts = append(parts, words)
+	}
+	return strings.Join(parts, " ")
+}
+
+func spellPortugueseBelow1000(n int) string {
+	if n == 100 {
+		return "cem"
+	}
+
+	parts := make([]string, 0, 3)
+	if n >= 100 {
+		parts = append(parts, portugueseHundreds[n/100])
+		n %= 100
+	}
+	if n >= 20 {
+		parts = append(parts, portugueseTens[n/10])
+		if n%10 > 0 {
+			parts = append(parts, portugueseUnits[n%10])
+		}
+	} else if n > 0 {
+		parts = append(parts, portugueseUnits[n])
+	}
+	return strings.Join(parts, " e ")
+}
+
+// returns 1000 to the power of the given exponent
+func pow1000(exp int) int64 {
+	p := int64(1)
+	for i := 0; i < exp; i++ {
+		p *= 1000
+	}
+	return p
+}
@@ -0,0 +1,82 @@
+package envs_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/goflow/envs"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		lang  envs.Language
+		n     int64
+		words string
+	}{
+		{envs.NilLanguage, 21, `twenty-one`},
+		{`eng`, 0, `zero`},
+		{`eng`, 15, `fifteen`},
+		{`eng`, 101, `one hundred one`},
+		{`eng`, 1234, `one thousand two hundred thirty-four`},
+		{`eng`, 2500000000, `two billion five hundred million`},
+		{`eng`, -7, `minus seven`},
+		{`spa`, 0, `cero`},
+		{`spa`, 16, `dieciséis`},
+		{`spa`, 21, `veintiuno`},
+		{`spa`, 31, `treinta y uno`},
+		{`spa`, 100, `cien`},
+		{`spa`, 101, `ciento uno`},
+		{`spa`, 1234, `mil doscientos treinta y cuatro`},
+		{`spa`, 21000, `veintiún mil`},
+		{`spa`, 101000, `ciento un mil`},
+		{`spa`, 1000000, `un millón`},
+		{`spa`, 31000000, `treinta y un millones`},
+		{`spa`, 2500000000, `dos mil quinientos millones`},
+		{`spa`, -7, `menos siete`},
+		{`fra`, 0, `zéro`},
+		{`fra`, 17, `dix-sept`},
+		{`fra`, 21, `vingt et un`},
+		{`fra`, 71, `soixante et onze`},
+		{`fra`, 80, `quatre-vingts`},
+		{`fra`, 81, `quatre-vingt-un`},
+		{`fra`, 99, `quatre-vingt-dix-neuf`},
+		{`fra`, 200, `deux cents`},
+		{`fra`, 201, `deux cent un`},
+		{`fra`, 1000, `mille`},
+		{`fra`, 80000, `quatre-vingt mille`},
+		{`fra`, 200000, `deux cent mille`},
+		{`fra`, 2000000, `deux millions`},
+		{`fra`, 280000000, `deux cent quatre-vingts millions`},
+		{`por`, 0, `zero`},
+		{`por`, 16, `dezesseis`},
+		{`por`, 21, `vinte e um`},
+		{`por`, 100, `cem`},
+		{`por`, 121, `cento e vinte e um`},
+		{`por`, 1001, `mil e um`},
+		{`por`, 1200, `mil e duzentos`},
+		{`por`, 1234, `mil duzentos e trinta e quatro`},
+		{`por`, 2000000, `dois milhões`},
+		{`por`, 2500000000, `dois bilhões e quinhentos milhões`},
+	}
+
+	for _, tc := range tests {
+		words, err := envs.NewLocale(tc.lang, envs.NilCountry).NumberToWords(decimal.New(tc.n, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.words, words, "words mismatch for %d in %s", tc.n, tc.lang)
+	}
+
+	_, err := envs.NewLocale(`kin`, envs.NilCountry).NumberToWords(decimal.New(5, 0))
+	assert.EqualError(t, err, "can't write numbers as words in language kin")
+
+	_, err = envs.NewLocale(`eng`, envs.NilCountry).NumberToWords(decimal.New(1000000000000, 0))
+	assert.EqualError(t, err, "can't write numbers larger than 999999999999 as words")
+
+	// numbers which would overflow an int64 are also too large
+	_, err = envs.NewLocale(`eng`, envs.NilCountry).NumberToWords(decimal.RequireFromString(`18446744073709551617`))
+	assert.EqualError(t, err, "can't write numbers larger than 999999999999 as words")
+
+	_, err = envs.NewLocale(`spa`, envs.NilCountry).NumberToWords(decimal.RequireFromString(`-18446744073709551617`))
+	assert.EqualError(t, err, "can't write numbers larger than 999999999999 as words")
+}
@@ -1,6 +1,7 @@
 package excellent
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/nyaruka/goflow/excellent/gen"
@@ -10,22 +11,41 @@ import (
 
 // VisitExpression parses and visits the given expression with the given visitor
 func VisitExpression(expression string, visitor antlr.ParseTreeVisitor) (interface{}, error) {
+	tree, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return visitor.Visit(tree), nil
+}
+
+// parses the given expression into a parse tree
+func parseExpression(expression string) (antlr.ParseTree, error) {
+	tree, errs := parseExpressionWithErrors(expression)
+
+	// if we ran into errors parsing, return the first one
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return tree, nil
+}
+
+// parses the given expression into a parse tree, returning all syntax errors encountered
+func parseExpressionWithErrors(expression string) (antlr.ParseTree, []*SyntaxError) {
 	errListener := NewErrorListener(expression)
 
 	input := antlr.NewInputStream(expression)
 	lexer := gen.NewExcellent2Lexer(input)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
 	stream := antlr.NewCommonTokenStream(lexer, 0)
 	p := gen.NewExcellent2Parser(stream)
 	p.RemoveErrorListeners()
 	p.AddErrorListener(errListener)
 	tree := p.Parse()
 
-	// if we ran into errors parsing, return the first one
-	if len(errListener.Errors()) > 0 {
-		return nil, errListener.Errors()[0]
-	}
-
-	return visitor.Visit(tree), nil
+	return tree, errListener.Errors()
 }
 
 // VisitTemplate scans the given template and calls the callback for each token encountered
@@ -35,20 +55,26 @@ func VisitTemplate(template string, allowedTopLevels []string, callback func(XTo
 		return nil
 	}
 
-	scanner := NewXScanner(strings.NewReader(template), allowedTopLevels)
+	scanner := NewXPositionScanner(strings.NewReader(template), allowedTopLevels)
 	errors := NewTemplateErrors()
 
 	for tokenType, token := scanner.Scan(); tokenType != EOF; tokenType, token = scanner.Scan() {
 		err := callback(tokenType, token)
 		if err != nil {
 			var repr string
+			var start int
 			if tokenType == IDENTIFIER {
-				repr = "@" + token
+				repr, start = "@"+token, scanner.Offset()+1
 			} else {
-				repr = "@(" + token + ")"
+				repr, start = "@("+token+")", scanner.Offset()+2
 			}
 
-			errors.Add(repr, err.Error())
+			// syntax errors are positioned relative to the start of the template
+			if syntaxErr, isSyntax := err.(*SyntaxError); isSyntax {
+				syntaxErr.Shift(template, start)
+			}
+
+			errors.AddError(repr, err)
 		}
 	}
 
@@ -58,6 +84,39 @@ func VisitTemplate(template string, allowedTopLevels []string, callback func(XTo
 	return nil
 }
 
+// ValidateTemplate scans the given template and parses its expressions without evaluating them, returning all
+// syntax errors found, positioned relative to the start of the template
+func ValidateTemplate(template string, allowedTopLevels []string) []*SyntaxError {
+	errs := make([]*SyntaxError, 0)
+
+	scanner := NewXPositionScanner(strings.NewReader(template), allowedTopLevels)
+
+	for tokenType, token := scanner.Scan(); tokenType != EOF; tokenType, token = scanner.Scan() {
+		var start int
+
+		switch tokenType {
+		case IDENTIFIER:
+			start = scanner.Offset() + 1
+		case EXPRESSION:
+			start = scanner.Offset() + 2
+		default:
+			continue
+		}
+
+		_, exprErrs := parseExpressionWithErrors(token)
+		for _, err := range exprErrs {
+			err.Shift(template, start)
+		}
+		errs = append(errs, exprErrs...)
+	}
+
+	// the scanner itself records things like unclosed expressions, which we merge in by position
+	errs = append(errs, scanner.Errors()...)
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Offset < errs[j].Offset })
+
+	return errs
+}
+
 // HasExpressions returns whether the given template contains any expressions or identifiers
 func HasExpressions(template string, allowedTopLevels []string) bool {
 	found := false
@@ -19,3 +19,41 @@ func TestHasExpressions(t *testing.T) {
 	assert.True(t, excellent.HasExpressions("hi @foo.x", topLevels))
 	assert.True(t, excellent.HasExpressions("hi @(foo)", topLevels))
 }
+
+func TestValidateTemplate(t *testing.T) {
+	type syntaxError struct {
+		line, column, offset int
+		token, message       string
+	}
+
+	testCases := []struct {
+		template string
+		errors   []syntaxError
+	}{
+		{``, []syntaxError{}},
+		{`Hi @foo.bar @(upper(foo)) bob@gmail.com`, []syntaxError{}},
+		{`Hi @(foo +)`, []syntaxError{
+			{1, 10, 10, "", "mismatched input '<EOF>' expecting {'(', '-', TEXT, INTEGER, DECIMAL, TRUE, FALSE, NULL, AND, OR, NOT, NAME}"},
+		}},
+		{`@(1 + 'x')`, []syntaxError{
+			{1, 6, 6, "'", "extraneous input ''' expecting {'(', '-', TEXT, INTEGER, DECIMAL, TRUE, FALSE, NULL, AND, OR, NOT, NAME}"},
+			{1, 8, 8, "'", "extraneous input ''' expecting <EOF>"},
+		}},
+		{"line 1\nline 2 @(upper(\"x\" \"y\"))\n@(foo..x) @(\"xyz", []syntaxError{
+			{2, 19, 26, `"y"`, `extraneous input '"y"' expecting ')'`},
+			{3, 6, 38, ".", "extraneous input '.' expecting {INTEGER, AND, OR, NOT, NAME}"},
+			{3, 12, 44, `"`, "unclosed text literal"},
+		}},
+	}
+
+	for _, tc := range testCases {
+		errs := excellent.ValidateTemplate(tc.template, []string{"foo"})
+
+		actual := make([]syntaxError, len(errs))
+		for i, err := range errs {
+			actual[i] = syntaxError{err.Line, err.Column, err.Offset, err.Token, err.Message}
+		}
+
+		assert.Equal(t, tc.errors, actual, "syntax errors mismatch for template: %s", tc.template)
+	}
+}
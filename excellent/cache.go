@@ -0,0 +1,72 @@
+package excellent
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+// TemplateCache is a least-recently-used cache of compiled templates
+type TemplateCache struct {
+	size    int
+	mutex   sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key      string
+	template *Template
+}
+
+// NewTemplateCache creates a new template cache which holds at most size templates
+func NewTemplateCache(size int) *TemplateCache {
+	return &TemplateCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+// Compile returns the compiled form of the given template, compiling it if it isn't already cached
+func (c *TemplateCache) Compile(template string, allowedTopLevels []string) *Template {
+	// how a template is scanned depends on the allowed top levels so they're part of the key
+	key := strings.Join(allowedTopLevels, ",") + "\x00" + template
+
+	c.mutex.Lock()
+	if element, cached := c.entries[key]; cached {
+		c.order.MoveToFront(element)
+		c.mutex.Unlock()
+		return element.Value.(*cacheEntry).template
+	}
+	c.mutex.Unlock()
+
+	// compile outside of the lock so other templates can be fetched meanwhile
+	compiled := CompileTemplate(template, allowedTopLevels)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, cached := c.entries[key]; cached {
+		c.order.MoveToFront(element)
+		return element.Value.(*cacheEntry).template
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, template: compiled})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+
+	return compiled
+}
+
+// Len returns the number of templates currently cached
+func (c *TemplateCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
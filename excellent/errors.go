@@ -7,19 +7,26 @@ import (
 	"github.com/nyaruka/goflow/utils"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
-	"github.com/pkg/errors"
 )
 
 // TemplateError is an error which occurs during evaluation of an expression
 type TemplateError struct {
-	expression string
-	message    string
+	expression  string
+	message     string
+	syntaxError *SyntaxError
 }
 
 func (e TemplateError) Error() string {
 	return fmt.Sprintf("error evaluating %s: %s", e.expression, e.message)
 }
 
+// Expression returns the expression which errored as it appears in the template, e.g. @(1 / 0)
+func (e *TemplateError) Expression() string { return e.expression }
+
+// SyntaxError returns the syntax error, positioned relative to the start of the template, if the expression
+// couldn't be parsed
+func (e *TemplateError) SyntaxError() *SyntaxError { return e.syntaxError }
+
 // TemplateErrors represents the list of all errors encountered during evaluation of a template
 type TemplateErrors struct {
 	errors []*TemplateError
@@ -35,6 +42,19 @@ func (e *TemplateErrors) Add(expression, message string) {
 	e.errors = append(e.errors, &TemplateError{expression: expression, message: message})
 }
 
+// AddError adds an error for the given expression, keeping the error if it's a syntax error so that its position
+// is available
+func (e *TemplateErrors) AddError(expression string, err error) {
+	syntaxError, _ := err.(*SyntaxError)
+
+	e.errors = append(e.errors, &TemplateError{expression: expression, message: err.Error(), syntaxError: syntaxError})
+}
+
+// Errors returns the individual errors
+func (e *TemplateErrors) Errors() []*TemplateError {
+	return e.errors
+}
+
 // HasErrors returns whether there are errors
 func (e *TemplateErrors) HasErrors() bool {
 	return len(e.errors) > 0
@@ -49,12 +69,33 @@ func (e *TemplateErrors) Error() string {
 	return strings.Join(messages, ", ")
 }
 
+// SyntaxError is a syntax error in a template or expression
+type SyntaxError struct {
+	Line    int    // line of the error, starting at 1
+	Column  int    // column of the error in characters, starting at 0
+	Offset  int    // offset of the error in characters from the start of the input
+	Token   string // the offending token, empty if the error is at the end of the input
+	Message string // description of the error
+	context string // the input at the position of the error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %s", e.context)
+}
+
+// Shift moves this error from being positioned in an expression to being positioned in the template which contains
+// it, where the expression starts at the given offset
+func (e *SyntaxError) Shift(template string, offset int) {
+	e.Offset += offset
+	e.Line, e.Column = positionOf(template, e.Offset)
+}
+
 // ErrorListener records syntax errors
 type ErrorListener struct {
 	*antlr.DefaultErrorListener
 
 	expression string
-	errors     []error
+	errors     []*SyntaxError
 }
 
 // NewErrorListener creates a new error listener
@@ -63,16 +104,62 @@ func NewErrorListener(expression string) *ErrorListener {
 }
 
 // Errors returns the errors encountered so far
-func (l *ErrorListener) Errors() []error {
+func (l *ErrorListener) Errors() []*SyntaxError {
 	return l.errors
 }
 
-// SyntaxError handles a new syntax error encountered by the recognizer
+// SyntaxError handles a new syntax error encountered by the lexer or parser
 func (l *ErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
 	// extract the part of the original expression where this error has occurred
 	lines := strings.Split(l.expression, "\n")
-	lineOfError := lines[line-1]
-	contextOfError := lineOfError[column:utils.MinInt(column+10, len(lineOfError))]
+	lineOfError := []rune(lines[line-1])
+	contextOfError := string(lineOfError[utils.MinInt(column, len(lineOfError)):utils.MinInt(column+10, len(lineOfError))])
+
+	// the parser gives us the offending token but the lexer only gives us a position
+	var token string
+	if t, isToken := offendingSymbol.(antlr.Token); isToken {
+		if t.GetTokenType() != antlr.TokenEOF {
+			token = t.GetText()
+		}
+	} else if column < len(lineOfError) {
+		token = string(lineOfError[column])
+	}
+
+	l.errors = append(l.errors, &SyntaxError{
+		Line:    line,
+		Column:  column,
+		Offset:  offsetOf(l.expression, line, column),
+		Token:   token,
+		Message: msg,
+		context: contextOfError,
+	})
+}
 
-	l.errors = append(l.errors, errors.Errorf("syntax error at %s", contextOfError))
+// gets the line (starting at 1) and column (starting at 0) of the given character offset in the given text
+func positionOf(text string, offset int) (int, int) {
+	line, column := 1, 0
+	for i, ch := range []rune(text) {
+		if i == offset {
+			break
+		}
+		if ch == '\n' {
+			line++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
+// gets the character offset of the given line (starting at 1) and column (starting at 0) in the given text
+func offsetOf(text string, line, column int) int {
+	offset := 0
+	for i, l := range strings.Split(text, "\n") {
+		if i == line-1 {
+			break
+		}
+		offset += len([]rune(l)) + 1
+	}
+	return offset + column
 }
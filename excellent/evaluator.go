@@ -19,60 +19,14 @@ type Escaping func(string) string
 
 // EvaluateTemplate evaluates the passed in template
 func EvaluateTemplate(env envs.Environment, context *types.XObject, template string, escaping Escaping) (string, error) {
-	var buf strings.Builder
-
-	err := VisitTemplate(template, context.Properties(), func(tokenType XTokenType, token string) error {
-		switch tokenType {
-		case BODY:
-			buf.WriteString(token)
-		case IDENTIFIER, EXPRESSION:
-			value := EvaluateExpression(env, context, token)
-
-			// if we got an error, return that
-			if types.IsXError(value) {
-				return value.(error)
-			}
-
-			// if not, stringify value and append to the output
-			asText, _ := types.ToXText(env, value)
-			asString := asText.Native()
-
-			if escaping != nil {
-				asString = escaping(asString)
-			}
-
-			buf.WriteString(asString)
-		}
-		return nil
-	})
-
-	return buf.String(), err
+	return CompileTemplate(template, context.Properties()).Evaluate(env, context, escaping)
 }
 
 // EvaluateTemplateValue is equivalent to EvaluateTemplate except in the case where the template contains
 // a single identifier or expression, ie: "@contact" or "@(first(contact.urns))". In these cases we return
 // the typed value from EvaluateExpression instead of stringifying the result.
 func EvaluateTemplateValue(env envs.Environment, context *types.XObject, template string) (types.XValue, error) {
-	template = strings.TrimSpace(template)
-	scanner := NewXScanner(strings.NewReader(template), context.Properties())
-
-	// parse our first token
-	tokenType, token := scanner.Scan()
-
-	// try to scan to our next token
-	nextTT, _ := scanner.Scan()
-
-	// if we only have an identifier or an expression, evaluate it on its own
-	if nextTT == EOF {
-		switch tokenType {
-		case IDENTIFIER, EXPRESSION:
-			return EvaluateExpression(env, context, token), nil
-		}
-	}
-
-	// otherwise fallback to full template evaluation
-	asStr, err := EvaluateTemplate(env, context, template, nil)
-	return types.NewXText(asStr), err
+	return CompileTemplate(template, context.Properties()).EvaluateValue(env, context)
 }
 
 // EvaluateExpression evalutes the passed in Excellent expression, returning the typed value it evaluates to,
@@ -93,6 +47,7 @@ type visitor struct {
 
 	env     envs.Environment
 	context *types.XObject
+	locals  map[string]types.XValue
 }
 
 // creates a new visitor for evaluation
@@ -134,7 +89,12 @@ func (v *visitor) VisitNumberLiteral(ctx *gen.NumberLiteralContext) interface{}
 func (v *visitor) VisitContextReference(ctx *gen.ContextReferenceContext) interface{} {
 	name := strings.ToLower(ctx.GetText())
 
-	// first of all try to look this up as a function
+	// arguments of an enclosing anonymous function take precedence over everything else
+	if value, isLocal := v.locals[name]; isLocal {
+		return value
+	}
+
+	// next try to look this up as a function
 	function := functions.Lookup(name)
 	if function != nil {
 		return toXValue(function)
@@ -155,13 +115,7 @@ func (v *visitor) VisitDotLookup(ctx *gen.DotLookupContext) interface{} {
 		return container
 	}
 
-	var lookup types.XText
-
-	if ctx.NAME() != nil {
-		lookup = types.NewXText(ctx.NAME().GetText())
-	} else {
-		lookup = types.NewXText(ctx.INTEGER().GetText())
-	}
+	lookup := types.NewXText(ctx.GetProperty().GetText())
 
 	return resolveLookup(v.env, container, lookup, lookupNotationDot)
 }
@@ -200,6 +154,33 @@ func (v *visitor) VisitFunctionCall(ctx *gen.FunctionCallContext) interface{} {
 	return functions.Call(v.env, name, asFunction, params)
 }
 
+// VisitAnonFunction deals with anonymous functions like (x) => x * 2
+func (v *visitor) VisitAnonFunction(ctx *gen.AnonFunctionContext) interface{} {
+	names := ctx.AllNAME()
+	argNames := make([]string, len(names))
+	for i := range names {
+		argNames[i] = strings.ToLower(names[i].GetText())
+	}
+
+	return types.XFunction(func(env envs.Environment, args ...types.XValue) types.XValue {
+		if len(args) != len(argNames) {
+			return types.NewXErrorf("need %d argument(s), got %d", len(argNames), len(args))
+		}
+
+		// arguments are added to any locals of the scope this function was defined in
+		locals := make(map[string]types.XValue, len(v.locals)+len(argNames))
+		for name, value := range v.locals {
+			locals[name] = value
+		}
+		for i, name := range argNames {
+			locals[name] = args[i]
+		}
+
+		scoped := &visitor{env: env, context: v.context, locals: locals}
+		return toXValue(scoped.Visit(ctx.Expression()))
+	})
+}
+
 // VisitTrue deals with the `true` reserved word
 func (v *visitor) VisitTrue(ctx *gen.TrueContext) interface{} {
 	return types.XBooleanTrue
@@ -293,6 +274,41 @@ func (v *visitor) VisitComparison(ctx *gen.ComparisonContext) interface{} {
 	}
 }
 
+// VisitNot deals with boolean negations such as not x
+func (v *visitor) VisitNot(ctx *gen.NotContext) interface{} {
+	arg := toXValue(v.Visit(ctx.Expression()))
+
+	return operators.Not(v.env, arg)
+}
+
+// VisitAnd deals with boolean conjunctions such as x and y, only evaluating y if x is truthy
+func (v *visitor) VisitAnd(ctx *gen.AndContext) interface{} {
+	arg1 := toXValue(v.Visit(ctx.Expression(0)))
+
+	return operators.And(v.env, arg1, v.lazy(ctx.Expression(1)))
+}
+
+// VisitOr deals with boolean disjunctions such as x or y, only evaluating y if x isn't truthy
+func (v *visitor) VisitOr(ctx *gen.OrContext) interface{} {
+	arg1 := toXValue(v.Visit(ctx.Expression(0)))
+
+	return operators.Or(v.env, arg1, v.lazy(ctx.Expression(1)))
+}
+
+// VisitCoalesce deals with null coalescing such as x ?? y, only evaluating y if x is null or an error
+func (v *visitor) VisitCoalesce(ctx *gen.CoalesceContext) interface{} {
+	arg1 := toXValue(v.Visit(ctx.Expression(0)))
+
+	return operators.Coalesce(v.env, arg1, v.lazy(ctx.Expression(1)))
+}
+
+// VisitConditional deals with conditionals such as x ? y : z, only evaluating the selected value
+func (v *visitor) VisitConditional(ctx *gen.ConditionalContext) interface{} {
+	test := toXValue(v.Visit(ctx.Expression(0)))
+
+	return operators.Conditional(v.env, test, v.lazy(ctx.Expression(1)), v.lazy(ctx.Expression(2)))
+}
+
 // VisitAtomReference deals with visiting a single atom in our expression
 func (v *visitor) VisitAtomReference(ctx *gen.AtomReferenceContext) interface{} {
 	return v.Visit(ctx.Atom())
@@ -309,6 +325,11 @@ func (v *visitor) VisitFunctionParameters(ctx *gen.FunctionParametersContext) in
 	return params
 }
 
+// returns a function which evaluates the given expression when called
+func (v *visitor) lazy(tree antlr.ParseTree) func() types.XValue {
+	return func() types.XValue { return toXValue(v.Visit(tree)) }
+}
+
 // convenience utility to convert the given value to an XValue. Might be able to rewrite the visitor in future
 // to only pass around XValues and then wouldn't need this
 func toXValue(val interface{}) types.XValue {
@@ -238,6 +238,55 @@ func TestEvaluateTemplate(t *testing.T) {
 		{`@(array(upper)[0]("hello"))`, "HELLO", false},
 		{`@(object("a", lower, "b", upper).a("Hello"))`, "hello", false},
 
+		// including anonymous functions
+		{`@((x) => x * 2)`, "function", false},
+		{`@(((x) => x * 2)(3))`, "6", false},
+		{`@(((x) => x * 2)(3) + 1)`, "7", false},
+		{`@(((a, b) => a & "-" & b)("x", "y"))`, "x-y", false},
+		{`@((() => "hi")())`, "hi", false},
+		{`@(((x) => (y) => x + y)(1)(2))`, "3", false},
+		{`@(((string1) => upper(string1))("local"))`, "LOCAL", false},
+		{`@(((upper) => upper)("shadowed"))`, "shadowed", false},
+		{`@(((x) => x * 2)())`, "", true},
+		{`@(((x) => x * 2)(1, 2))`, "", true},
+		{`@((x, 1) => x)`, "", true},
+		{`@(filter(array1, (x) => text_length(x) > 3))`, "[three]", false},
+		{`@(find(array1, (x) => x != string1 & "x"))`, "one", false},
+		{`@(sort_by(array1, (x) => text_length(x) * -1))`, "[three, one, two]", false},
+		{`@(reduce(array1, (acc, x) => acc & upper(x), ""))`, "ONETWOTHREE", false},
+		{`@(plural(4 / 4, "item", "items"))`, "item", false},
+		{`@(plural(int1 + 0.0, "item", "items"))`, "item", false},
+		{`@(plural(3 / 2, "item", "items"))`, "items", false},
+
+		// boolean, coalescing and conditional operators
+		{`@(true and false)`, "false", false},
+		{`@(int1 = 1 AND string1 = "foo")`, "true", false},
+		{`@(false or int2 > int1)`, "true", false},
+		{`@(not true)`, "false", false},
+		{`@(not int1 > int2)`, "true", false},
+		{`@(not false and false)`, "false", false},
+		{`@(true or false and false)`, "true", false},
+		{`@(false and 1 / 0)`, "false", false},
+		{`@(true or 1 / 0)`, "true", false},
+		{`@(true and 1 / 0)`, "", true},
+		{`@(err or true)`, "", true},
+		{`@(and(true, false))`, "false", false},
+		{`@(or(false, true))`, "true", false},
+		{`@(thing.missing ?? "none")`, "none", false},
+		{`@(err ?? "none")`, "none", false},
+		{`@(string1 ?? "none")`, "foo", false},
+		{`@(thing.xxx ?? thing.missing ?? "none")`, "none", false},
+		{`@(string1 ?? 1 / 0)`, "foo", false},
+		{`@(int1 + int2 ?? 0)`, "3", false},
+		{`@(int1 < int2 ? "less" : "more")`, "less", false},
+		{`@(int1 > int2 ? "more" : int1 = int2 ? "same" : "less")`, "less", false},
+		{`@(true ? 1 / 0 : 2)`, "", true},
+		{`@(false ? 1 / 0 : 2)`, "2", false},
+		{`@(err ? 1 : 2)`, "", true},
+		{`@(if(int1 = 1, "yes", "no"))`, "yes", false},
+		{`@(filter(array1, (x) => x = "one" or x = "two"))`, "[one, two]", false},
+		{`@(object("and", 1, "or", 2, "not", 3).and + object("or", 2).or + object("not", 3).NOT)`, "6", false},
+
 		// an identifier which isn't valid top-level is ignored completely
 		{"@hello", "@hello", false},
 		{"@hello.bar", "@hello.bar", false},
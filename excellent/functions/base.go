@@ -15,6 +15,34 @@ func RegisterXFunction(name string, function types.XFunction) {
 	XFUNCTIONS[name] = function
 }
 
+// ParamType is the type of value which a function parameter requires
+type ParamType string
+
+// the types of value which a function parameter can require
+const (
+	ParamTypeAny      ParamType = ""
+	ParamTypeNumber   ParamType = "number"
+	ParamTypeFunction ParamType = "function"
+)
+
+// XFUNCTION_PARAM_TYPES is our map of the types of value required by the parameters of functions
+var XFUNCTION_PARAM_TYPES = map[string][]ParamType{}
+
+// RegisterParamTypes declares the types of value required by the parameters of a function, in parameter order
+func RegisterParamTypes(name string, paramTypes ...ParamType) {
+	XFUNCTION_PARAM_TYPES[name] = paramTypes
+}
+
+// LookupParamType returns the type of value required by the parameter at the given index of the function with
+// the given name (case-insensitive), or ParamTypeAny if no type has been declared
+func LookupParamType(name string, index int) ParamType {
+	paramTypes := XFUNCTION_PARAM_TYPES[strings.ToLower(name)]
+	if index < len(paramTypes) {
+		return paramTypes[index]
+	}
+	return ParamTypeAny
+}
+
 // Lookup returns the function with the given name (case-insensitive) or nil
 func Lookup(name string) types.XFunction {
 	return XFUNCTIONS[strings.ToLower(name)]
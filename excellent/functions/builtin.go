@@ -2,11 +2,17 @@ package functions
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"html"
 	"math"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -14,10 +20,12 @@ import (
 
 	"github.com/nyaruka/gocommon/urns"
 	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/operators"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/utils"
 	"github.com/nyaruka/goflow/utils/dates"
 	"github.com/nyaruka/goflow/utils/random"
+	"github.com/nyaruka/goflow/utils/uuids"
 
 	"github.com/shopspring/decimal"
 )
@@ -62,7 +70,9 @@ func init() {
 		"upper":             OneTextFunction(Upper),
 		"percent":           OneNumberFunction(Percent),
 		"url_encode":        OneTextFunction(URLEncode),
+		"url_decode":        OneTextFunction(URLDecode),
 		"html_decode":       OneTextFunction(HTMLDecode),
+		"plural":            MinAndMaxArgsCheck(2, 3, Plural),
 
 		// bool functions
 		"and": MinArgsCheck(1, And),
@@ -86,6 +96,7 @@ func init() {
 		"datetime_from_epoch": OneNumberFunction(DateTimeFromEpoch),
 		"datetime_diff":       ThreeArgFunction(DateTimeDiff),
 		"datetime_add":        DateTimeAdd,
+		"duration":            TwoArgFunction(Duration),
 		"replace_time":        TwoArgFunction(ReplaceTime),
 		"tz":                  OneDateTimeFunction(TZ),
 		"tz_offset":           OneDateTimeFunction(TZOffset),
@@ -106,9 +117,34 @@ func init() {
 		"urn_parts":        OneTextFunction(URNParts),
 		"attachment_parts": OneTextFunction(AttachmentParts),
 
+		// encoding and hashing functions
+		"base64_encode": OneTextFunction(Base64Encode),
+		"base64_decode": OneTextFunction(Base64Decode),
+		"hex_encode":    OneTextFunction(HexEncode),
+		"md5":           OneTextFunction(MD5),
+		"sha256":        OneTextFunction(SHA256),
+		"hmac_sha256":   InitialTextFunction(1, 2, HMACSHA256),
+
 		// json functions
 		"json":       OneArgFunction(JSON),
 		"parse_json": OneTextFunction(ParseJSON),
+		"json_path":  TwoArgFunction(JSONPath),
+
+		// object functions
+		"merge":      MinArgsCheck(1, Merge),
+		"set_key":    ThreeArgFunction(SetKey),
+		"delete_key": TwoArgFunction(DeleteKey),
+		"keys":       OneArgFunction(Keys),
+		"values":     OneArgFunction(Values),
+
+		// array functions
+		"slice":    MinAndMaxArgsCheck(2, 3, Slice),
+		"concat":   MinArgsCheck(1, Concat),
+		"unique":   OneArgFunction(Unique),
+		"sort":     OneArgFunction(Sort),
+		"contains": TwoArgFunction(Contains),
+		"index_of": TwoArgFunction(IndexOf),
+		"sum":      OneArgFunction(Sum),
 
 		// formatting functions
 		"format":          OneArgFunction(Format),
@@ -118,6 +154,9 @@ func init() {
 		"format_location": OneTextFunction(FormatLocation),
 		"format_number":   MinAndMaxArgsCheck(1, 3, FormatNumber),
 		"format_urn":      OneTextFunction(FormatURN),
+		"format_list":     OneArgFunction(FormatList),
+		"format_currency": TwoArgFunction(FormatCurrency),
+		"number_to_words": OneNumberFunction(NumberToWords),
 
 		// utility functions
 		"is_error":       OneArgFunction(IsError),
@@ -129,11 +168,70 @@ func init() {
 		"extract_object": MinArgsCheck(2, ExtractObject),
 		"foreach":        MinArgsCheck(2, ForEach),
 		"foreach_value":  MinArgsCheck(2, ForEachValue),
+		"filter":         TwoArgFunction(Filter),
+		"find":           TwoArgFunction(Find),
+		"sort_by":        TwoArgFunction(SortBy),
+		"reduce":         ThreeArgFunction(Reduce),
+		"uuid":           NoArgFunction(UUID),
 	}
 
 	for name, fn := range builtin {
 		RegisterXFunction(name, fn)
 	}
+
+	// parameters which require a particular type of value, used when type checking templates
+	paramTypes := map[string][]ParamType{
+		// text functions
+		"char":       {ParamTypeNumber},
+		"word":       {ParamTypeAny, ParamTypeNumber},
+		"word_slice": {ParamTypeAny, ParamTypeNumber, ParamTypeNumber},
+		"field":      {ParamTypeAny, ParamTypeNumber},
+		"text_slice": {ParamTypeAny, ParamTypeNumber, ParamTypeNumber},
+		"repeat":     {ParamTypeAny, ParamTypeNumber},
+		"replace":    {ParamTypeAny, ParamTypeAny, ParamTypeAny, ParamTypeNumber},
+		"percent":    {ParamTypeNumber},
+		"plural":     {ParamTypeNumber},
+
+		// number functions
+		"round":        {ParamTypeNumber, ParamTypeNumber},
+		"round_up":     {ParamTypeNumber, ParamTypeNumber},
+		"round_down":   {ParamTypeNumber, ParamTypeNumber},
+		"max":          {ParamTypeNumber},
+		"min":          {ParamTypeNumber},
+		"mean":         {ParamTypeNumber},
+		"mod":          {ParamTypeNumber, ParamTypeNumber},
+		"rand_between": {ParamTypeNumber, ParamTypeNumber},
+		"abs":          {ParamTypeNumber},
+
+		// datetime functions
+		"datetime_from_epoch": {ParamTypeNumber},
+		"datetime_add":        {ParamTypeAny, ParamTypeNumber},
+		"duration":            {ParamTypeNumber},
+
+		// date and time functions
+		"date_from_parts": {ParamTypeNumber, ParamTypeNumber, ParamTypeNumber},
+		"time_from_parts": {ParamTypeNumber, ParamTypeNumber, ParamTypeNumber},
+
+		// array functions
+		"slice": {ParamTypeAny, ParamTypeNumber, ParamTypeNumber},
+
+		// formatting functions
+		"format_number":   {ParamTypeNumber, ParamTypeNumber},
+		"format_currency": {ParamTypeNumber},
+		"number_to_words": {ParamTypeNumber},
+
+		// utility functions
+		"foreach":       {ParamTypeAny, ParamTypeFunction},
+		"foreach_value": {ParamTypeAny, ParamTypeFunction},
+		"filter":        {ParamTypeAny, ParamTypeFunction},
+		"find":          {ParamTypeAny, ParamTypeFunction},
+		"sort_by":       {ParamTypeAny, ParamTypeFunction},
+		"reduce":        {ParamTypeAny, ParamTypeFunction},
+	}
+
+	for name, pts := range paramTypes {
+		RegisterParamTypes(name, pts...)
+	}
 }
 
 //------------------------------------------------------------------------------------------
@@ -255,7 +353,7 @@ func Time(env envs.Environment, value types.XValue) types.XValue {
 //   @(count(array())) -> 0
 //   @(count(array("a", "b"))) -> 2
 //
-// @function array(values...)
+// @function array([values...])
 func Array(env envs.Environment, values ...types.XValue) types.XValue {
 	// check none of our args are errors
 	for _, arg := range values {
@@ -273,7 +371,7 @@ func Array(env envs.Environment, values ...types.XValue) types.XValue {
 //   @(object("a", 123, "b", "hello")) -> {a: 123, b: hello}
 //   @(object("a")) -> ERROR
 //
-// @function object(pairs...)
+// @function object([pairs...])
 func Object(env envs.Environment, pairs ...types.XValue) types.XValue {
 	// check none of our args are errors
 	for _, arg := range pairs {
@@ -945,6 +1043,23 @@ func URLEncode(env envs.Environment, text types.XText) types.XValue {
 	return types.NewXText(encoded)
 }
 
+// URLDecode decodes `text` which has been encoded for use as a URL parameter.
+//
+// It is the inverse of [function:url_encode].
+//
+//   @(url_decode("two%20%26%20words")) -> two & words
+//   @(url_decode("two+words")) -> two words
+//   @(url_decode("100%")) -> ERROR
+//
+// @function url_decode(text)
+func URLDecode(env envs.Environment, text types.XText) types.XValue {
+	decoded, err := url.QueryUnescape(text.Native())
+	if err != nil {
+		return types.NewXErrorf("%s isn't valid URL encoded text", text.Describe())
+	}
+	return types.NewXText(decoded)
+}
+
 // HTMLDecode HTML decodes `text`
 //
 //   @(html_decode("Red &amp; Blue")) -> Red & Blue
@@ -959,6 +1074,66 @@ func HTMLDecode(env envs.Environment, text types.XText) types.XValue {
 	return types.NewXText(decoded)
 }
 
+// Plural returns the form of a word to use with `number` according to the plural rules of the current language.
+//
+// The `forms` can be an object with a form for each plural category (zero, one, two, few, many and other) used by
+// the language, or for languages like English which only have one and other, just the one form followed by the
+// `other` form. The other form is used for any category which doesn't have a form.
+//
+//   @(plural(1, "item", "items")) -> item
+//   @(plural(3, "item", "items")) -> items
+//   @(plural(1.5, "item", "items")) -> items
+//   @("You have " & 2 & " " & plural(2, "message", "messages")) -> You have 2 messages
+//   @(plural(2, object("one", "item", "other", "items"))) -> items
+//   @(plural(2, object("one", "item"))) -> ERROR
+//   @(plural("x", "item", "items")) -> ERROR
+//
+// @function plural(number, forms [,other])
+func Plural(env envs.Environment, args ...types.XValue) types.XValue {
+	number, xerr := types.ToXNumber(env, args[0])
+	if xerr != nil {
+		return xerr
+	}
+
+	category := pluralCategory(env.DefaultLocale(), number.Native())
+
+	// forms given as one and other
+	if len(args) == 3 {
+		if category == "one" {
+			return Text(env, args[1])
+		}
+		return Text(env, args[2])
+	}
+
+	forms, isObject := args[1].(*types.XObject)
+	if !isObject {
+		return types.NewXErrorf("forms must be an object if other isn't provided")
+	}
+
+	form, found := forms.Get(category)
+	if !found {
+		if form, found = forms.Get("other"); !found {
+			return types.NewXErrorf("forms has no form for %s or other", category)
+		}
+	}
+
+	return Text(env, form)
+}
+
+// gets the plural category of the given number in the given locale
+func pluralCategory(locale envs.Locale, number decimal.Decimal) string {
+	number = number.Abs()
+
+	// visible fraction digits are taken from the number as it renders, so computed values like 4 / 4, which
+	// internally have a scale of 16, are treated as integers
+	var fraction string
+	if asText := number.String(); strings.IndexByte(asText, '.') >= 0 {
+		fraction = asText[strings.IndexByte(asText, '.')+1:]
+	}
+
+	return locale.PluralCategory(int(number.Truncate(0).IntPart()%10000000), fraction)
+}
+
 //------------------------------------------------------------------------------------------
 // Number Functions
 //------------------------------------------------------------------------------------------
@@ -1139,7 +1314,7 @@ func Rand(env envs.Environment) types.XValue {
 //   @(rand_between(1, 10)) -> 10
 //   @(rand_between(1, 10)) -> 2
 //
-// @function rand_between()
+// @function rand_between(min, max)
 func RandBetween(env envs.Environment, min types.XNumber, max types.XNumber) types.XValue {
 	span := (max.Native().Sub(min.Native())).Add(decimal.New(1, 0))
 
@@ -1346,13 +1521,55 @@ func DateTimeAdd(env envs.Environment, args ...types.XValue) types.XValue {
 	return types.NewXErrorf("unknown unit: %s, must be one of s, m, h, D, W, M, Y", unit)
 }
 
+// Duration creates a duration of `amount` number of `unit`.
+//
+// Valid units are "Y" for years, "M" for months, "W" for weeks, "D" for days, "h" for hours,
+// "m" for minutes, "s" for seconds.
+//
+//   @(duration(5, "D")) -> P5D
+//   @(duration(-90, "m")) -> -PT1H30M
+//   @(datetime("2017-01-15T10:00:00Z") + duration(3, "W")) -> 2017-02-05T10:00:00.000000Z
+//   @(duration(5, "x")) -> ERROR
+//
+// @function duration(amount, unit)
+func Duration(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	amount, xerr := types.ToInteger(env, arg1)
+	if xerr != nil {
+		return xerr
+	}
+
+	unit, xerr := types.ToXText(env, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	switch unit.Native() {
+	case "s":
+		return types.NewXDuration(0, 0, time.Duration(amount)*time.Second)
+	case "m":
+		return types.NewXDuration(0, 0, time.Duration(amount)*time.Minute)
+	case "h":
+		return types.NewXDuration(0, 0, time.Duration(amount)*time.Hour)
+	case "D":
+		return types.NewXDuration(0, amount, 0)
+	case "W":
+		return types.NewXDuration(0, amount*7, 0)
+	case "M":
+		return types.NewXDuration(amount, 0, 0)
+	case "Y":
+		return types.NewXDuration(amount*12, 0, 0)
+	}
+
+	return types.NewXErrorf("unknown unit: %s, must be one of s, m, h, D, W, M, Y", unit)
+}
+
 // ReplaceTime returns a new datetime with the time part replaced by the `time`.
 //
 //   @(replace_time(now(), "10:30")) -> 2018-04-11T10:30:00.000000-05:00
 //   @(replace_time("2017-01-15", "10:30")) -> 2017-01-15T10:30:00.000000-05:00
 //   @(replace_time("foo", "10:30")) -> ERROR
 //
-// @function replace_time(datetime)
+// @function replace_time(datetime, time)
 func ReplaceTime(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
 	date, xerr := types.ToXDateTime(env, arg1)
 	if xerr != nil {
@@ -1623,6 +1840,401 @@ func JSON(env envs.Environment, value types.XValue) types.XValue {
 	return asJSON
 }
 
+// JSONPath queries `value` with the JSONPath expression `path` and returns an array of all matching values.
+//
+// Supported syntax is `.key` or `['key']` for properties, `[n]` for array items, `[from:]` or `[:to]` for
+// array slices, `*` for all properties or items, and `..key` to search at any depth. Negative indexes count
+// from the end of an array. The leading `$` is optional. An empty array is returned if nothing matches.
+//
+//   @(json_path(parse_json("{\"items\": [{\"id\": 1}, {\"id\": 2}]}"), "$.items[*].id")) -> [1, 2]
+//   @(json_path(parse_json("{\"items\": [{\"id\": 1}, {\"id\": 2}]}"), "items[-1]")) -> [{id: 2}]
+//   @(json_path(parse_json("{\"a\": {\"id\": 1}, \"b\": [{\"id\": 2}]}"), "$..id")) -> [1, 2]
+//   @(json_path(parse_json("[1, 2, 3, 4]"), "$[-3:-1]")) -> [2, 3]
+//   @(json_path(object("foo", 1), "$.bar")) -> []
+//   @(json_path(object("foo", 1), "$.foo[")) -> ERROR
+//
+// @function json_path(value, path)
+func JSONPath(env envs.Environment, value types.XValue, path types.XValue) types.XValue {
+	if types.IsXError(value) {
+		return value
+	}
+
+	pathText, xerr := types.ToXText(env, path)
+	if xerr != nil {
+		return xerr
+	}
+
+	steps, err := parseJSONPath(pathText.Native())
+	if err != nil {
+		return types.NewXError(err)
+	}
+
+	return types.NewXArray(evaluateJSONPath(value, steps)...)
+}
+
+//----------------------------------------------------------------------------------------
+// Object Functions
+//----------------------------------------------------------------------------------------
+
+// Merge returns a new object with the properties of all the given `objects`.
+//
+// Where more than one object has the same property, the value from the last one is used.
+//
+//   @(merge(object("a", 1, "b", 2), object("b", 3, "c", 4))) -> {a: 1, b: 3, c: 4}
+//   @(merge(object("a", 1), object("A", 2))) -> {A: 2}
+//   @(merge(object("a", 1), "foo")) -> ERROR
+//
+// @function merge(objects...)
+func Merge(env envs.Environment, args ...types.XValue) types.XValue {
+	result := make(map[string]types.XValue)
+
+	for _, arg := range args {
+		object, xerr := types.ToXObject(env, arg)
+		if xerr != nil {
+			return xerr
+		}
+
+		for _, prop := range object.Properties() {
+			value, _ := object.Get(prop)
+			setProperty(result, prop, value)
+		}
+	}
+
+	return types.NewXObject(result)
+}
+
+// SetKey returns a copy of `object` with the property `key` set to `value`.
+//
+//   @(set_key(object("a", 1), "b", 2)) -> {a: 1, b: 2}
+//   @(set_key(object("a", 1), "A", 2)) -> {A: 2}
+//   @(set_key("foo", "b", 2)) -> ERROR
+//
+// @function set_key(object, key, value)
+func SetKey(env envs.Environment, arg1 types.XValue, arg2 types.XValue, value types.XValue) types.XValue {
+	object, xerr := types.ToXObject(env, arg1)
+	if xerr != nil {
+		return xerr
+	}
+
+	key, xerr := types.ToXText(env, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	result := copyProperties(object)
+	setProperty(result, key.Native(), value)
+
+	return types.NewXObject(result)
+}
+
+// DeleteKey returns a copy of `object` without the property `key`.
+//
+//   @(delete_key(object("a", 1, "b", 2), "a")) -> {b: 2}
+//   @(delete_key(object("a", 1), "c")) -> {a: 1}
+//   @(delete_key("foo", "a")) -> ERROR
+//
+// @function delete_key(object, key)
+func DeleteKey(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	object, xerr := types.ToXObject(env, arg1)
+	if xerr != nil {
+		return xerr
+	}
+
+	key, xerr := types.ToXText(env, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	result := copyProperties(object)
+	deleteProperty(result, key.Native())
+
+	return types.NewXObject(result)
+}
+
+// Keys returns an array of the property names of `object` in alphabetical order.
+//
+//   @(keys(object("b", 1, "a", 2))) -> [a, b]
+//   @(keys(object())) -> []
+//   @(keys("foo")) -> ERROR
+//
+// @function keys(object)
+func Keys(env envs.Environment, arg types.XValue) types.XValue {
+	object, xerr := types.ToXObject(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	props := object.Properties()
+	keys := make([]types.XValue, len(props))
+	for i, prop := range props {
+		keys[i] = types.NewXText(prop)
+	}
+
+	return types.NewXArray(keys...)
+}
+
+// Values returns an array of the property values of `object` ordered by their property names.
+//
+//   @(values(object("b", 1, "a", 2))) -> [2, 1]
+//   @(values(object())) -> []
+//   @(values("foo")) -> ERROR
+//
+// @function values(object)
+func Values(env envs.Environment, arg types.XValue) types.XValue {
+	object, xerr := types.ToXObject(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	props := object.Properties()
+	values := make([]types.XValue, len(props))
+	for i, prop := range props {
+		values[i], _ = object.Get(prop)
+	}
+
+	return types.NewXArray(values...)
+}
+
+// copies the properties of the given object into a new map
+func copyProperties(object *types.XObject) map[string]types.XValue {
+	props := object.Properties()
+	result := make(map[string]types.XValue, len(props))
+	for _, prop := range props {
+		result[prop], _ = object.Get(prop)
+	}
+	return result
+}
+
+// sets a property in the given map, replacing any existing property whose name differs only by case
+func setProperty(props map[string]types.XValue, key string, value types.XValue) {
+	deleteProperty(props, key)
+	props[key] = value
+}
+
+// deletes a property from the given map, ignoring case
+func deleteProperty(props map[string]types.XValue, key string) {
+	for existing := range props {
+		if strings.EqualFold(existing, key) {
+			delete(props, existing)
+		}
+	}
+}
+
+//----------------------------------------------------------------------------------------
+// Array Functions
+//----------------------------------------------------------------------------------------
+
+// Slice returns the items of `array` from the index `start` up to but not including the index `end`.
+//
+// Negative indexes count from the end of the array. If `end` is omitted, items are returned up to the
+// end of the array.
+//
+//   @(slice(array("a", "b", "c", "d"), 1, 3)) -> [b, c]
+//   @(slice(array("a", "b", "c", "d"), 2)) -> [c, d]
+//   @(slice(array("a", "b", "c", "d"), -1)) -> [d]
+//   @(slice(array("a", "b", "c", "d"), 3, 1)) -> []
+//   @(slice(array("a", "b"), "x")) -> ERROR
+//
+// @function slice(array, start [,end])
+func Slice(env envs.Environment, args ...types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, args[0])
+	if xerr != nil {
+		return xerr
+	}
+
+	start, xerr := types.ToInteger(env, args[1])
+	if xerr != nil {
+		return xerr
+	}
+
+	var end *int
+	if len(args) == 3 {
+		e, xerr := types.ToInteger(env, args[2])
+		if xerr != nil {
+			return xerr
+		}
+		end = &e
+	}
+
+	from, to := sliceBounds(array.Count(), &start, end)
+	result := make([]types.XValue, 0, to-from)
+	for i := from; i < to; i++ {
+		result = append(result, array.Get(i))
+	}
+
+	return types.NewXArray(result...)
+}
+
+// Concat returns a new array containing the items of all the given `arrays` in order.
+//
+//   @(concat(array("a", "b"), array("c"), array())) -> [a, b, c]
+//   @(concat(array(1, 2), array(2, 3))) -> [1, 2, 2, 3]
+//   @(concat(array(1), "foo")) -> ERROR
+//
+// @function concat(arrays...)
+func Concat(env envs.Environment, args ...types.XValue) types.XValue {
+	result := make([]types.XValue, 0)
+
+	for _, arg := range args {
+		array, xerr := types.ToXArray(env, arg)
+		if xerr != nil {
+			return xerr
+		}
+
+		for i := 0; i < array.Count(); i++ {
+			result = append(result, array.Get(i))
+		}
+	}
+
+	return types.NewXArray(result...)
+}
+
+// Unique returns a new array with duplicate items removed from `array`, keeping the first of each.
+//
+// Items are considered duplicates if they are textually equal, as with the `=` operator.
+//
+//   @(unique(array("a", "b", "a", "c", "b"))) -> [a, b, c]
+//   @(unique(array(1, "1", 1.0))) -> [1]
+//   @(unique("foo")) -> ERROR
+//
+// @function unique(array)
+func Unique(env envs.Environment, arg types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	result := make([]types.XValue, 0, array.Count())
+	for i := 0; i < array.Count(); i++ {
+		item := array.Get(i)
+
+		index, xerr := indexOfItem(env, result, item)
+		if xerr != nil {
+			return xerr
+		}
+		if index < 0 {
+			result = append(result, item)
+		}
+	}
+
+	return types.NewXArray(result...)
+}
+
+// Sort returns a new array with the items of `array` in ascending order.
+//
+// Arrays of numbers, dates or datetimes are sorted by value. All other arrays are sorted by
+// the text of their items.
+//
+//   @(sort(array(10, 2, 33))) -> [2, 10, 33]
+//   @(sort(array("b", "c", "a"))) -> [a, b, c]
+//   @(sort(array(date("2020-02-01"), date("2019-12-25")))) -> [2019-12-25, 2020-02-01]
+//   @(sort("foo")) -> ERROR
+//
+// @function sort(array)
+func Sort(env envs.Environment, arg types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	items := make([]types.XValue, array.Count())
+	for i := range items {
+		items[i] = array.Get(i)
+	}
+
+	// items are their own sort keys
+	return sortByKeys(env, items, items)
+}
+
+// Contains returns whether `array` contains an item equal to `value`.
+//
+// Items are compared textually, as with the `=` operator.
+//
+//   @(contains(array("a", "b", "c"), "b")) -> true
+//   @(contains(array(1, 2, 3), 4)) -> false
+//   @(contains(array(1, 2, 3), "2")) -> true
+//   @(contains("foo", "f")) -> ERROR
+//
+// @function contains(array, value)
+func Contains(env envs.Environment, arg types.XValue, value types.XValue) types.XValue {
+	index := IndexOf(env, arg, value)
+	if types.IsXError(index) {
+		return index
+	}
+
+	return types.NewXBoolean(index.(types.XNumber).Native().Sign() >= 0)
+}
+
+// IndexOf returns the index of the first item in `array` which is equal to `value`, or -1 if there is none.
+//
+// Items are compared textually, as with the `=` operator.
+//
+//   @(index_of(array("a", "b", "c", "b"), "b")) -> 1
+//   @(index_of(array(1, 2, 3), "3")) -> 2
+//   @(index_of(array(1, 2, 3), 4)) -> -1
+//   @(index_of("foo", "f")) -> ERROR
+//
+// @function index_of(array, value)
+func IndexOf(env envs.Environment, arg types.XValue, value types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	items := make([]types.XValue, array.Count())
+	for i := range items {
+		items[i] = array.Get(i)
+	}
+
+	index, xerr := indexOfItem(env, items, value)
+	if xerr != nil {
+		return xerr
+	}
+
+	return types.NewXNumberFromInt(index)
+}
+
+// Sum returns the sum of the numbers in `array`.
+//
+//   @(sum(array(1, 2, 3.5))) -> 6.5
+//   @(sum(array("10", 5))) -> 15
+//   @(sum(array())) -> 0
+//   @(sum(array(1, "foo"))) -> ERROR
+//
+// @function sum(array)
+func Sum(env envs.Environment, arg types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	sum := decimal.Zero
+
+	for i := 0; i < array.Count(); i++ {
+		num, xerr := types.ToXNumber(env, array.Get(i))
+		if xerr != nil {
+			return xerr
+		}
+		sum = sum.Add(num.Native())
+	}
+
+	return types.NewXNumber(sum)
+}
+
+// returns the index of the first of the given items which equals value, or -1
+func indexOfItem(env envs.Environment, items []types.XValue, value types.XValue) (int, types.XError) {
+	for i, item := range items {
+		equal := operators.Equal(env, item, value)
+		if types.IsXError(equal) {
+			return -1, equal.(types.XError)
+		}
+		if equal.Truthy() {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
 //----------------------------------------------------------------------------------------
 // Formatting Functions
 //----------------------------------------------------------------------------------------
@@ -1879,6 +2491,198 @@ func FormatURN(env envs.Environment, arg types.XText) types.XValue {
 	return types.NewXText(urn.Format())
 }
 
+// FormatList formats `array` as a list of items in the current language.
+//
+// The items are separated with commas, except for the last two which are joined with the conjunction of the
+// language, e.g. "and" in English or "y" in Spanish.
+//
+//   @(format_list(array("red", "green", "blue"))) -> red, green and blue
+//   @(format_list(array("red", "green"))) -> red and green
+//   @(format_list(array("red"))) -> red
+//   @(format_list(foreach(contact.groups, extract, "name"))) -> Testers and Males
+//   @(format_list("red")) -> ERROR
+//
+// @function format_list(array)
+func FormatList(env envs.Environment, arg types.XValue) types.XValue {
+	array, xerr := types.ToXArray(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	items := make([]string, array.Count())
+	for i := range items {
+		asText, xerr := types.ToXText(env, array.Get(i))
+		if xerr != nil {
+			return xerr
+		}
+		items[i] = asText.Native()
+	}
+
+	if len(items) <= 1 {
+		return types.NewXText(strings.Join(items, ""))
+	}
+
+	last := len(items) - 1
+	conjunction := env.DefaultLocale().ListConjunction()
+
+	return types.NewXText(strings.Join(items[:last], ", ") + " " + conjunction + " " + items[last])
+}
+
+// FormatCurrency formats `amount` as money in the currency with the ISO 4217 code `currency_code`.
+//
+// The currency symbol and digit grouping follow the language and country of the contact or environment.
+// Amounts are rounded to the usual number of decimal places for the currency.
+//
+//   @(format_currency(1234.5, "USD")) -> $ 1,234.50
+//   @(format_currency(1234.5, "RWF")) -> RWF 1,235
+//   @(format_currency(-3.999, "EUR")) -> -€ 4.00
+//   @(format_currency(10, "XYZ")) -> ERROR
+//   @(format_currency("foo", "USD")) -> ERROR
+//
+// @function format_currency(amount, currency_code)
+func FormatCurrency(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	amount, xerr := types.ToXNumber(env, arg1)
+	if xerr != nil {
+		return xerr
+	}
+
+	code, xerr := types.ToXText(env, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	formatted, err := env.DefaultLocale().FormatCurrency(amount.Native(), strings.ToUpper(code.Native()))
+	if err != nil {
+		return types.NewXError(err)
+	}
+
+	return types.NewXText(formatted)
+}
+
+// NumberToWords writes the whole number `number` as words in the language of the contact or environment.
+//
+// Numbers can be written in English, French, Portuguese and Spanish. An error is returned for other
+// languages, or if the number isn't a whole number.
+//
+//   @(number_to_words(21)) -> twenty-one
+//   @(number_to_words(1234)) -> one thousand two hundred thirty-four
+//   @(number_to_words(-5)) -> minus five
+//   @(number_to_words(1.5)) -> ERROR
+//
+// @function number_to_words(number)
+func NumberToWords(env envs.Environment, num types.XNumber) types.XValue {
+	if !num.Native().Equal(num.Native().Truncate(0)) {
+		return types.NewXErrorf("can only write whole numbers as words")
+	}
+
+	words, err := env.DefaultLocale().NumberToWords(num.Native())
+	if err != nil {
+		return types.NewXError(err)
+	}
+
+	return types.NewXText(words)
+}
+
+//------------------------------------------------------------------------------------------
+// Encoding and Hashing Functions
+//------------------------------------------------------------------------------------------
+
+// Base64Encode encodes `text` as base64.
+//
+//   @(base64_encode("Hello World")) -> SGVsbG8gV29ybGQ=
+//
+// @function base64_encode(text)
+func Base64Encode(env envs.Environment, text types.XText) types.XValue {
+	return types.NewXText(base64.StdEncoding.EncodeToString([]byte(text.Native())))
+}
+
+// Base64Decode decodes base64 encoded `text`.
+//
+// It is the inverse of [function:base64_encode]. An error is returned if the text isn't valid base64 or doesn't decode
+// to valid text.
+//
+//   @(base64_decode("SGVsbG8gV29ybGQ=")) -> Hello World
+//   @(base64_decode("not base64")) -> ERROR
+//
+// @function base64_decode(text)
+func Base64Decode(env envs.Environment, text types.XText) types.XValue {
+	decoded, err := base64.StdEncoding.DecodeString(text.Native())
+	if err != nil {
+		return types.NewXErrorf("%s isn't valid base64 encoded text", text.Describe())
+	}
+	if !utf8.Valid(decoded) {
+		return types.NewXErrorf("%s doesn't decode to valid text", text.Describe())
+	}
+	return types.NewXText(string(decoded))
+}
+
+// HexEncode encodes `text` as hexadecimal, with two lowercase digits for each byte.
+//
+//   @(hex_encode("Hi!")) -> 486921
+//   @(hex_encode("✓")) -> e29c93
+//
+// @function hex_encode(text)
+func HexEncode(env envs.Environment, text types.XText) types.XValue {
+	return types.NewXText(hex.EncodeToString([]byte(text.Native())))
+}
+
+// MD5 returns the MD5 hash of `text` as hexadecimal.
+//
+//   @(md5("Hello World")) -> b10a8db164e0754105b7a99be72e3fe5
+//
+// @function md5(text)
+func MD5(env envs.Environment, text types.XText) types.XValue {
+	hash := md5.Sum([]byte(text.Native()))
+	return types.NewXText(hex.EncodeToString(hash[:]))
+}
+
+// SHA256 returns the SHA-256 hash of `text` as hexadecimal.
+//
+//   @(sha256("Hello World")) -> a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e
+//
+// @function sha256(text)
+func SHA256(env envs.Environment, text types.XText) types.XValue {
+	hash := sha256.Sum256([]byte(text.Native()))
+	return types.NewXText(hex.EncodeToString(hash[:]))
+}
+
+// HMACSHA256 returns the HMAC-SHA256 signature of `text` using `key`.
+//
+// The signature is encoded as hexadecimal unless `encoding` is `base64`.
+//
+//   @(hmac_sha256("Hello World", "secret")) -> 82ce0d2f821fa0ce5447b21306f214c99240fecc6387779d7515148bbdd0c415
+//   @(hmac_sha256("Hello World", "secret", "base64")) -> gs4NL4IfoM5UR7ITBvIUyZJA/sxjh3eddRUUi73QxBU=
+//   @(hmac_sha256("Hello World", "secret", "binary")) -> ERROR
+//
+// @function hmac_sha256(text, key [,encoding])
+func HMACSHA256(env envs.Environment, text types.XText, args ...types.XValue) types.XValue {
+	key, xerr := types.ToXText(env, args[0])
+	if xerr != nil {
+		return xerr
+	}
+
+	encoding := "hex"
+	if len(args) == 2 {
+		xencoding, xerr := types.ToXText(env, args[1])
+		if xerr != nil {
+			return xerr
+		}
+		encoding = strings.ToLower(xencoding.Native())
+	}
+
+	mac := hmac.New(sha256.New, []byte(key.Native()))
+	mac.Write([]byte(text.Native()))
+	signature := mac.Sum(nil)
+
+	switch encoding {
+	case "hex":
+		return types.NewXText(hex.EncodeToString(signature))
+	case "base64":
+		return types.NewXText(base64.StdEncoding.EncodeToString(signature))
+	}
+	return types.NewXErrorf("unknown encoding %s, must be hex or base64", encoding)
+}
+
 //------------------------------------------------------------------------------------------
 // Utility Functions
 //------------------------------------------------------------------------------------------
@@ -2067,6 +2871,130 @@ func ForEachValue(env envs.Environment, args ...types.XValue) types.XValue {
 	return types.NewXObject(result)
 }
 
+// Filter creates a new array containing only the items of `values` for which `func` returns a truthy value.
+//
+//   @(filter(array(1, 5, 12, 20), (x) => x > 10)) -> [12, 20]
+//   @(filter(array("a", "", "b"), (x) => x)) -> [a, b]
+//   @(filter(array(1, 2), (x) => x / 0)) -> ERROR
+//
+// @function filter(values, func)
+func Filter(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	array, function, xerr := arrayAndFunction(env, arg1, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	result := make([]types.XValue, 0, array.Count())
+
+	for i := 0; i < array.Count(); i++ {
+		item := array.Get(i)
+
+		include, xerr := callPredicate(env, function, item)
+		if xerr != nil {
+			return xerr
+		}
+		if include {
+			result = append(result, item)
+		}
+	}
+
+	return types.NewXArray(result...)
+}
+
+// Find returns the first item of `values` for which `func` returns a truthy value, or null if there is none.
+//
+//   @(find(array(1, 5, 12, 20), (x) => x > 10)) -> 12
+//   @(find(array(1, 5), (x) => x > 10)) ->
+//   @(find(array(1, 2), (x) => x / 0)) -> ERROR
+//
+// @function find(values, func)
+func Find(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	array, function, xerr := arrayAndFunction(env, arg1, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	for i := 0; i < array.Count(); i++ {
+		item := array.Get(i)
+
+		found, xerr := callPredicate(env, function, item)
+		if xerr != nil {
+			return xerr
+		}
+		if found {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// SortBy creates a new array by sorting `values` by the keys returned by applying `func` to each item.
+//
+// Keys are compared as numbers, dates or datetimes if they are all of that type, and as text otherwise.
+//
+//   @(sort_by(array(3, 1, 2), (x) => x)) -> [1, 2, 3]
+//   @(sort_by(array("bb", "a", "ccc"), (x) => text_length(x))) -> [a, bb, ccc]
+//   @(sort_by(array(3, 1, 2), (x) => -x)) -> [3, 2, 1]
+//   @(sort_by(array(1, 2), (x) => x / 0)) -> ERROR
+//
+// @function sort_by(values, func)
+func SortBy(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	array, function, xerr := arrayAndFunction(env, arg1, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	items := make([]types.XValue, array.Count())
+	keys := make([]types.XValue, array.Count())
+
+	for i := 0; i < array.Count(); i++ {
+		items[i] = array.Get(i)
+		keys[i] = Call(env, function.Describe(), function, []types.XValue{items[i]})
+		if types.IsXError(keys[i]) {
+			return keys[i]
+		}
+	}
+
+	return sortByKeys(env, items, keys)
+}
+
+// Reduce combines the items of `values` into a single value by calling `func` with the result so far
+// and each item in turn, starting with `initial`.
+//
+//   @(reduce(array(1, 2, 3), (total, x) => total + x, 0)) -> 6
+//   @(reduce(array("a", "b", "c"), (s, x) => s & x, "")) -> abc
+//   @(reduce(array(1, 2), (total, x) => total / 0, 0)) -> ERROR
+//
+// @function reduce(values, func, initial)
+func Reduce(env envs.Environment, arg1 types.XValue, arg2 types.XValue, initial types.XValue) types.XValue {
+	array, function, xerr := arrayAndFunction(env, arg1, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	result := initial
+
+	for i := 0; i < array.Count(); i++ {
+		result = Call(env, function.Describe(), function, []types.XValue{result, array.Get(i)})
+		if types.IsXError(result) {
+			return result
+		}
+	}
+
+	return result
+}
+
+// UUID generates a new random UUID.
+//
+//   @(text_length(uuid())) -> 36
+//   @(uuid() = uuid()) -> false
+//
+// @function uuid()
+func UUID(env envs.Environment) types.XValue {
+	return types.NewXText(string(uuids.New()))
+}
+
 // LegacyAdd simulates our old + operator, which operated differently based on whether
 // one of the parameters was a date or not. If one is a date, then the other side is
 // expected to be an integer with a number of days to add to the date, otherwise a normal
@@ -2165,3 +3093,81 @@ func ReadChars(env envs.Environment, val types.XText) types.XValue {
 
 	return types.NewXText(output.String())
 }
+
+// converts the first two arguments of a function which applies a function to each item of an array
+func arrayAndFunction(env envs.Environment, arg1 types.XValue, arg2 types.XValue) (*types.XArray, types.XFunction, types.XError) {
+	array, xerr := types.ToXArray(env, arg1)
+	if xerr != nil {
+		return nil, nil, xerr
+	}
+
+	function, isFunction := arg2.(types.XFunction)
+	if !isFunction {
+		return nil, nil, types.NewXErrorf("requires an function as its second argument")
+	}
+
+	return array, function, nil
+}
+
+// calls the given function with a single item and returns whether the result is truthy
+func callPredicate(env envs.Environment, function types.XFunction, item types.XValue) (bool, types.XError) {
+	result := Call(env, function.Describe(), function, []types.XValue{item})
+	if types.IsXError(result) {
+		return false, result.(types.XError)
+	}
+
+	return types.Truthy(result), nil
+}
+
+// sorts the given items by the given keys, keeping items with equal keys in their original order
+func sortByKeys(env envs.Environment, items []types.XValue, keys []types.XValue) types.XValue {
+	less, xerr := sortKeysLess(env, keys)
+	if xerr != nil {
+		return xerr
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool { return less(indexes[i], indexes[j]) })
+
+	result := make([]types.XValue, len(items))
+	for i, index := range indexes {
+		result[i] = items[index]
+	}
+
+	return types.NewXArray(result...)
+}
+
+// creates a less function for the given sort keys, comparing them by type if they all have the same type
+func sortKeysLess(env envs.Environment, keys []types.XValue) (func(i, j int) bool, types.XError) {
+	allOfType := func(is func(types.XValue) bool) bool {
+		for _, key := range keys {
+			if !is(key) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if allOfType(func(x types.XValue) bool { _, is := x.(types.XNumber); return is }) {
+		return func(i, j int) bool { return keys[i].(types.XNumber).Compare(keys[j].(types.XNumber)) < 0 }, nil
+	}
+	if allOfType(func(x types.XValue) bool { _, is := x.(types.XDateTime); return is }) {
+		return func(i, j int) bool { return keys[i].(types.XDateTime).Compare(keys[j].(types.XDateTime)) < 0 }, nil
+	}
+	if allOfType(func(x types.XValue) bool { _, is := x.(types.XDate); return is }) {
+		return func(i, j int) bool { return keys[i].(types.XDate).Compare(keys[j].(types.XDate)) < 0 }, nil
+	}
+
+	texts := make([]types.XText, len(keys))
+	for i, key := range keys {
+		text, xerr := types.ToXText(env, key)
+		if xerr != nil {
+			return nil, xerr
+		}
+		texts[i] = text
+	}
+	return func(i, j int) bool { return texts[i].Compare(texts[j]) < 0 }, nil
+}
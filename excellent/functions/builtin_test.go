@@ -12,6 +12,7 @@ import (
 	"github.com/nyaruka/goflow/test"
 	"github.com/nyaruka/goflow/utils/dates"
 	"github.com/nyaruka/goflow/utils/random"
+	"github.com/nyaruka/goflow/utils/uuids"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,8 @@ func TestFunctions(t *testing.T) {
 		WithTimeFormat(envs.TimeFormatHourMinuteAmPm).
 		WithTimezone(la).
 		Build()
+	spa := envs.NewBuilder().WithDefaultLanguage(envs.Language("spa")).Build()
+	rus := envs.NewBuilder().WithDefaultLanguage(envs.Language("rus")).Build()
 
 	var funcTests = []struct {
 		name     string
@@ -73,6 +76,20 @@ func TestFunctions(t *testing.T) {
 		{"attachment_parts", dmy, []types.XValue{ERROR}, ERROR},
 		{"attachment_parts", dmy, []types.XValue{}, ERROR},
 
+		{"base64_decode", dmy, []types.XValue{xs("SGVsbG8gV29ybGQ=")}, xs("Hello World")},
+		{"base64_decode", dmy, []types.XValue{xs("4pyT")}, xs("✓")},
+		{"base64_decode", dmy, []types.XValue{xs("")}, xs("")},
+		{"base64_decode", dmy, []types.XValue{xs("SGVsbG8")}, ERROR},
+		{"base64_decode", dmy, []types.XValue{xs("/w==")}, ERROR},
+		{"base64_decode", dmy, []types.XValue{ERROR}, ERROR},
+		{"base64_decode", dmy, []types.XValue{}, ERROR},
+
+		{"base64_encode", dmy, []types.XValue{xs("Hello World")}, xs("SGVsbG8gV29ybGQ=")},
+		{"base64_encode", dmy, []types.XValue{xs("✓")}, xs("4pyT")},
+		{"base64_encode", dmy, []types.XValue{xs("")}, xs("")},
+		{"base64_encode", dmy, []types.XValue{ERROR}, ERROR},
+		{"base64_encode", dmy, []types.XValue{}, ERROR},
+
 		{"boolean", dmy, []types.XValue{xs("abc")}, types.XBooleanTrue},
 		{"boolean", dmy, []types.XValue{xs("false")}, types.XBooleanFalse},
 		{"boolean", dmy, []types.XValue{xs("FALSE")}, types.XBooleanFalse},
@@ -100,6 +117,23 @@ func TestFunctions(t *testing.T) {
 			[]types.XValue{types.NewXObject(map[string]types.XValue{"a": xs("hello"), "b": xi(3)})},
 			xi(2),
 		},
+		{"concat", dmy, []types.XValue{xa(xs("a"), xi(1)), xa(), xa(xs("b"))}, xa(xs("a"), xi(1), xs("b"))},
+		{"concat", dmy, []types.XValue{xa(xs("a"))}, xa(xs("a"))},
+		{"concat", dmy, []types.XValue{xa(xs("a")), nil}, xa(xs("a"))},
+		{"concat", dmy, []types.XValue{xa(xs("a")), xs("b")}, ERROR},
+		{"concat", dmy, []types.XValue{xa(xs("a")), ERROR}, ERROR},
+		{"concat", dmy, []types.XValue{}, ERROR},
+
+		{"contains", dmy, []types.XValue{xa(xs("a"), xi(1)), xi(1)}, types.XBooleanTrue},
+		{"contains", dmy, []types.XValue{xa(xs("a"), xi(1)), xs("A")}, types.XBooleanFalse},
+		{"contains", dmy, []types.XValue{xa(xa(xs("a"))), xa(xs("a"))}, types.XBooleanTrue},
+		{"contains", dmy, []types.XValue{xa(xs("a"), nil), nil}, types.XBooleanTrue},
+		{"contains", dmy, []types.XValue{xa(xi(1), xi(2)), xs("2")}, types.XBooleanTrue},
+		{"contains", dmy, []types.XValue{xa(xi(1), xi(2)), ERROR}, ERROR},
+		{"contains", dmy, []types.XValue{xs("abc"), xs("a")}, ERROR},
+		{"contains", dmy, []types.XValue{ERROR, xs("a")}, ERROR},
+		{"contains", dmy, []types.XValue{xa()}, ERROR},
+
 		{"count", dmy, []types.XValue{xa(xs("hello"), xi(3))}, xi(2)},
 		{"count", dmy, []types.XValue{xa()}, xi(0)},
 		{"count", dmy, []types.XValue{nil}, xi(0)},
@@ -201,6 +235,24 @@ func TestFunctions(t *testing.T) {
 		{"default", dmy, []types.XValue{types.NewXErrorf("This is error"), xs("20")}, xs("20")},
 		{"default", dmy, []types.XValue{}, ERROR},
 
+		{"duration", dmy, []types.XValue{xi(30), xs("s")}, types.NewXDuration(0, 0, 30*time.Second)},
+		{"duration", dmy, []types.XValue{xs("-5"), xs("m")}, types.NewXDuration(0, 0, -5*time.Minute)},
+		{"duration", dmy, []types.XValue{xi(2), xs("h")}, types.NewXDuration(0, 0, 2*time.Hour)},
+		{"duration", dmy, []types.XValue{xi(3), xs("D")}, types.NewXDuration(0, 3, 0)},
+		{"duration", dmy, []types.XValue{xi(2), xs("W")}, types.NewXDuration(0, 14, 0)},
+		{"duration", dmy, []types.XValue{xi(4), xs("M")}, types.NewXDuration(4, 0, 0)},
+		{"duration", dmy, []types.XValue{xi(2), xs("Y")}, types.NewXDuration(24, 0, 0)},
+		{"duration", dmy, []types.XValue{xi(2), xs("Z")}, ERROR},
+		{"duration", dmy, []types.XValue{xs("x"), xs("D")}, ERROR},
+		{"duration", dmy, []types.XValue{xi(2), ERROR}, ERROR},
+		{"duration", dmy, []types.XValue{xi(2)}, ERROR},
+
+		{"delete_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1), "b": xi(2)}), xs("A")}, types.NewXObject(map[string]types.XValue{"b": xi(2)})},
+		{"delete_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), xs("c")}, types.NewXObject(map[string]types.XValue{"a": xi(1)})},
+		{"delete_key", dmy, []types.XValue{xs("abc"), xs("a")}, ERROR},
+		{"delete_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), ERROR}, ERROR},
+		{"delete_key", dmy, []types.XValue{ERROR, xs("a")}, ERROR},
+
 		{"extract", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"foo": xs("hello")}), xs("foo")}, xs("hello")},
 		{"extract", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"foo": xs("hello")}), xs("bar")}, nil},
 		{"extract", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"foo": xs("hello")}), xs("foo"), xs("bar")}, ERROR},
@@ -256,6 +308,21 @@ func TestFunctions(t *testing.T) {
 		{"field", dmy, []types.XValue{xs("hello"), xs("1"), ERROR}, ERROR},
 		{"field", dmy, []types.XValue{}, ERROR},
 
+		{"filter", dmy, []types.XValue{xa(xs("a"), xs(""), xs("b")), xf("text")}, xa(xs("a"), xs("b"))},
+		{"filter", dmy, []types.XValue{xa(xi(1), xi(-2), xi(3)), xf("abs")}, xa(xi(1), xi(-2), xi(3))},
+		{"filter", dmy, []types.XValue{xa(), xf("text")}, xa()},
+		{"filter", dmy, []types.XValue{ERROR, xf("text")}, ERROR},
+		{"filter", dmy, []types.XValue{xa(xs("a"), xs("b")), ERROR}, ERROR},
+		{"filter", dmy, []types.XValue{xa(xs("a"), xs("b")), xf("abs")}, ERROR},
+		{"filter", dmy, []types.XValue{xa(xs("a"))}, ERROR},
+
+		{"find", dmy, []types.XValue{xa(xs(""), xs("a"), xs("b")), xf("text")}, xs("a")},
+		{"find", dmy, []types.XValue{xa(xs(""), xs("")), xf("text")}, nil},
+		{"find", dmy, []types.XValue{ERROR, xf("text")}, ERROR},
+		{"find", dmy, []types.XValue{xa(xs("a"), xs("b")), ERROR}, ERROR},
+		{"find", dmy, []types.XValue{xa(xs("a"), xs("b")), xf("abs")}, ERROR},
+		{"find", dmy, []types.XValue{xa(xs("a"))}, ERROR},
+
 		{"foreach", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xf("upper")}, xa(xs("A"), xs("B"), xs("C"))},
 		{"foreach", dmy, []types.XValue{xa(xs("the man"), xs("fox"), xs("jumped up")), xf("word"), xi(0)}, xa(xs("the"), xs("fox"), xs("jumped"))},
 		{"foreach", dmy, []types.XValue{ERROR, xf("upper")}, ERROR},
@@ -275,8 +342,19 @@ func TestFunctions(t *testing.T) {
 		{"format", dmy, []types.XValue{xn("1234")}, xs("1,234")},
 		{"format", dmy, []types.XValue{xd(dates.NewDate(2017, 6, 12))}, xs("12-06-2017")},
 		{"format", dmy, []types.XValue{xdt(time.Date(2017, 6, 12, 16, 56, 59, 0, time.UTC))}, xs("12-06-2017 16:56")},
+		{"format", dmy, []types.XValue{types.NewXDuration(1, 2, 90*time.Minute)}, xs("1M 2D 1h 30m")},
 		{"format", dmy, []types.XValue{nil}, xs("")},
 
+		{"format_currency", dmy, []types.XValue{xn("1234.567"), xs("USD")}, xs("$ 1,234.57")},
+		{"format_currency", dmy, []types.XValue{xi(1234), xs("usd")}, xs("$ 1,234.00")},
+		{"format_currency", dmy, []types.XValue{xs("-12.5"), xs("EUR")}, xs("-€ 12.50")},
+		{"format_currency", spa, []types.XValue{xn("1234.5"), xs("USD")}, xs("US$ 1.234,50")},
+		{"format_currency", rus, []types.XValue{xn("1234.5"), xs("RUB")}, xs("₽ 1\u00a0234,50")},
+		{"format_currency", dmy, []types.XValue{xi(10), xs("XYZ")}, ERROR},
+		{"format_currency", dmy, []types.XValue{xs("abc"), xs("USD")}, ERROR},
+		{"format_currency", dmy, []types.XValue{xi(10), ERROR}, ERROR},
+		{"format_currency", dmy, []types.XValue{xi(10)}, ERROR},
+
 		{"format_date", dmy, []types.XValue{xs("1977-06-23T15:34:00.000000Z")}, xs("23-06-1977")},
 		{"format_date", mdy, []types.XValue{xs("1977-06-23T15:34:00.000000Z")}, xs("06-23-1977")},
 		{"format_date", dmy, []types.XValue{xs("1977-06-23T15:34:00.000000Z"), xs("YYYY-MM-DD")}, xs("1977-06-23")},
@@ -316,6 +394,15 @@ func TestFunctions(t *testing.T) {
 		{"format_location", dmy, []types.XValue{ERROR}, ERROR},
 		{"format_location", dmy, []types.XValue{}, ERROR},
 
+		{"format_list", dmy, []types.XValue{xa()}, xs("")},
+		{"format_list", dmy, []types.XValue{xa(xs("a"))}, xs("a")},
+		{"format_list", dmy, []types.XValue{xa(xs("a"), xi(2))}, xs("a and 2")},
+		{"format_list", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c"), xs("d"))}, xs("a, b, c and d")},
+		{"format_list", spa, []types.XValue{xa(xs("a"), xs("b"), xs("c"))}, xs("a, b y c")},
+		{"format_list", dmy, []types.XValue{xa(xs("a"), ERROR)}, ERROR},
+		{"format_list", dmy, []types.XValue{ERROR}, ERROR},
+		{"format_list", dmy, []types.XValue{}, ERROR},
+
 		{"format_number", dmy, []types.XValue{xn("1234")}, xs("1,234")},
 		{"format_number", dmy, []types.XValue{xn("1234.5670")}, xs("1,234.567")},
 		{"format_number", dmy, []types.XValue{xn("1234.5670"), xi(2)}, xs("1,234.57")},
@@ -335,6 +422,22 @@ func TestFunctions(t *testing.T) {
 		{"format_urn", dmy, []types.XValue{ERROR}, ERROR},
 		{"format_urn", dmy, []types.XValue{}, ERROR},
 
+		{"hex_encode", dmy, []types.XValue{xs("Hi!")}, xs("486921")},
+		{"hex_encode", dmy, []types.XValue{xi(10)}, xs("3130")},
+		{"hex_encode", dmy, []types.XValue{xs("")}, xs("")},
+		{"hex_encode", dmy, []types.XValue{ERROR}, ERROR},
+		{"hex_encode", dmy, []types.XValue{}, ERROR},
+
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret")}, xs("82ce0d2f821fa0ce5447b21306f214c99240fecc6387779d7515148bbdd0c415")},
+		{"hmac_sha256", dmy, []types.XValue{xs(""), xs("")}, xs("b613679a0814d9ec772f95d778c35fc5ff1697c493715653c6c712144292c5ad")},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), ERROR}, ERROR},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret"), xs("HEX")}, xs("82ce0d2f821fa0ce5447b21306f214c99240fecc6387779d7515148bbdd0c415")},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret"), xs("base64")}, xs("gs4NL4IfoM5UR7ITBvIUyZJA/sxjh3eddRUUi73QxBU=")},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret"), xs("binary")}, ERROR},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret"), ERROR}, ERROR},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World")}, ERROR},
+		{"hmac_sha256", dmy, []types.XValue{xs("Hello World"), xs("secret"), xs("hex"), xs("hex")}, ERROR},
+
 		{"html_decode", dmy, []types.XValue{xs(`Red&nbsp;&amp;&nbsp;Blue`)}, xs(`Red & Blue`)},
 		{"html_decode", dmy, []types.XValue{ERROR}, ERROR},
 		{"html_decode", dmy, []types.XValue{}, ERROR},
@@ -345,6 +448,15 @@ func TestFunctions(t *testing.T) {
 		{"if", dmy, []types.XValue{}, ERROR},
 		{"if", dmy, []types.XValue{errorArg, xs("10"), xs("20")}, types.NewXErrorf("error calling IF: I am error")},
 
+		{"index_of", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("b")), xs("b")}, xi(1)},
+		{"index_of", dmy, []types.XValue{xa(xs("a"), xs("b")), xs("c")}, xi(-1)},
+		{"index_of", dmy, []types.XValue{xa(xi(1), xn("2.0")), xi(2)}, xi(1)},
+		{"index_of", dmy, []types.XValue{xa(xi(1), xi(2)), xs("2")}, xi(1)},
+		{"index_of", dmy, []types.XValue{xa(xi(1), xi(2)), ERROR}, ERROR},
+		{"index_of", dmy, []types.XValue{xa(), xs("c")}, xi(-1)},
+		{"index_of", dmy, []types.XValue{xs("abc"), xs("a")}, ERROR},
+		{"index_of", dmy, []types.XValue{ERROR, xs("a")}, ERROR},
+
 		{"is_error", dmy, []types.XValue{xs("hello")}, types.XBooleanFalse},
 		{"is_error", dmy, []types.XValue{nil}, types.XBooleanFalse},
 		{"is_error", dmy, []types.XValue{types.NewXErrorf("I am error")}, types.XBooleanTrue},
@@ -363,6 +475,27 @@ func TestFunctions(t *testing.T) {
 		{"json", dmy, []types.XValue{nil}, xs(`null`)},
 		{"json", dmy, []types.XValue{ERROR}, ERROR},
 
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`{"a": [{"b": 1}, {"b": 2, "c": {"b": 3}}]}`)), xs("$.a[*].b")}, xa(xi(1), xi(2))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`{"a": [{"b": 1}, {"b": 2, "c": {"b": 3}}]}`)), xs("$..b")}, xa(xi(1), xi(2), xi(3))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`{"a": [{"b": 1}, {"b": 2, "c": {"b": 3}}]}`)), xs("a[1].c['b']")}, xa(xi(3))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`{"a b": {"x": 1, "y": 2}}`)), xs(`$["a b"].*`)}, xa(xi(1), xi(2))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`[1, 2, 3, 4]`)), xs("$[1:]")}, xa(xi(2), xi(3), xi(4))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`[1, 2, 3, 4]`)), xs("$[:-2]")}, xa(xi(1), xi(2))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`[1, 2, 3, 4]`)), xs("$[-1]")}, xa(xi(4))},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`[1, 2, 3, 4]`)), xs("$[10]")}, xa()},
+		{"json_path", dmy, []types.XValue{types.JSONToXValue([]byte(`[1, 2, 3, 4]`)), xs("$")}, xa(xa(xi(1), xi(2), xi(3), xi(4)))},
+		{"json_path", dmy, []types.XValue{xs("abc"), xs("$.a")}, xa()},
+		{"json_path", dmy, []types.XValue{xa(xi(1)), xs("$[x]")}, ERROR},
+		{"json_path", dmy, []types.XValue{xa(xi(1)), xs("$['a")}, ERROR},
+		{"json_path", dmy, []types.XValue{xa(xi(1)), xs("$.a..")}, ERROR},
+		{"json_path", dmy, []types.XValue{ERROR, xs("$")}, ERROR},
+		{"json_path", dmy, []types.XValue{xa(xi(1)), ERROR}, ERROR},
+
+		{"keys", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"B": xi(1), "a": xi(2)})}, xa(xs("B"), xs("a"))},
+		{"keys", dmy, []types.XValue{nil}, xa()},
+		{"keys", dmy, []types.XValue{xa(xi(1))}, ERROR},
+		{"keys", dmy, []types.XValue{ERROR}, ERROR},
+
 		{"legacy_add", dmy, []types.XValue{xs("01-12-2017"), xi(2)}, xdt(time.Date(2017, 12, 3, 0, 0, 0, 0, time.UTC))},
 		{"legacy_add", dmy, []types.XValue{xs("2"), xs("01-12-2017 10:15:33pm")}, xdt(time.Date(2017, 12, 3, 22, 15, 33, 0, time.UTC))},
 		{"legacy_add", dmy, []types.XValue{xs("2"), xs("3.5")}, xn("5.5")},
@@ -395,6 +528,17 @@ func TestFunctions(t *testing.T) {
 		{"min", dmy, []types.XValue{xs("9"), xs("not_num")}, ERROR},
 		{"min", dmy, []types.XValue{}, ERROR},
 
+		{"merge", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1), "b": xi(2)}), types.NewXObject(map[string]types.XValue{"B": xi(3)}), types.NewXObject(map[string]types.XValue{"c": xi(4)})}, types.NewXObject(map[string]types.XValue{"a": xi(1), "B": xi(3), "c": xi(4)})},
+		{"merge", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)})}, types.NewXObject(map[string]types.XValue{"a": xi(1)})},
+		{"merge", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), xs("x")}, ERROR},
+		{"merge", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), ERROR}, ERROR},
+		{"merge", dmy, []types.XValue{}, ERROR},
+
+		{"md5", dmy, []types.XValue{xs("Hello World")}, xs("b10a8db164e0754105b7a99be72e3fe5")},
+		{"md5", dmy, []types.XValue{xs("")}, xs("d41d8cd98f00b204e9800998ecf8427e")},
+		{"md5", dmy, []types.XValue{ERROR}, ERROR},
+		{"md5", dmy, []types.XValue{}, ERROR},
+
 		{"mean", dmy, []types.XValue{xs("10"), xs("11")}, xn("10.5")},
 		{"mean", dmy, []types.XValue{xs("10.2")}, xn("10.2")},
 		{"mean", dmy, []types.XValue{xs("not_num")}, ERROR},
@@ -407,6 +551,17 @@ func TestFunctions(t *testing.T) {
 		{"mod", dmy, []types.XValue{xs("9"), xs("not_num")}, ERROR},
 		{"mod", dmy, []types.XValue{}, ERROR},
 
+		{"number_to_words", dmy, []types.XValue{xi(0)}, xs("zero")},
+		{"number_to_words", dmy, []types.XValue{xs("342")}, xs("three hundred forty-two")},
+		{"number_to_words", dmy, []types.XValue{xn("21.0")}, xs("twenty-one")},
+		{"number_to_words", spa, []types.XValue{xi(21000)}, xs("veintiún mil")},
+		{"number_to_words", rus, []types.XValue{xi(5)}, ERROR},
+		{"number_to_words", dmy, []types.XValue{xn("1.5")}, ERROR},
+		{"number_to_words", dmy, []types.XValue{xn("18446744073709551617")}, ERROR},
+		{"number_to_words", spa, []types.XValue{xn("-18446744073709551617")}, ERROR},
+		{"number_to_words", dmy, []types.XValue{xs("abc")}, ERROR},
+		{"number_to_words", dmy, []types.XValue{}, ERROR},
+
 		{"now", dmy, []types.XValue{}, xdt(time.Date(2018, 4, 11, 13, 24, 30, 123456000, time.UTC))},
 		{"now", dmy, []types.XValue{ERROR}, ERROR},
 
@@ -451,6 +606,23 @@ func TestFunctions(t *testing.T) {
 		{"parse_json", dmy, []types.XValue{xs(`{a: b}`)}, ERROR},
 		{"parse_json", dmy, []types.XValue{ERROR}, ERROR},
 
+		{"plural", dmy, []types.XValue{xi(1), xs("item"), xs("items")}, xs("item")},
+		{"plural", dmy, []types.XValue{xi(-1), xs("item"), xs("items")}, xs("item")},
+		{"plural", dmy, []types.XValue{xi(0), xs("item"), xs("items")}, xs("items")},
+		{"plural", dmy, []types.XValue{xs("1"), xs("item"), xs("items")}, xs("item")},
+		{"plural", dmy, []types.XValue{xn("1.000"), xs("item"), xs("items")}, xs("item")},
+		{"plural", spa, []types.XValue{xi(1), xs("mensaje"), xs("mensajes")}, xs("mensaje")},
+		{"plural", spa, []types.XValue{xi(5), xs("mensaje"), xs("mensajes")}, xs("mensajes")},
+		{"plural", rus, []types.XValue{xi(21), types.NewXObject(map[string]types.XValue{"one": xs("книга"), "few": xs("книги"), "many": xs("книг")})}, xs("книга")},
+		{"plural", rus, []types.XValue{xi(3), types.NewXObject(map[string]types.XValue{"one": xs("книга"), "few": xs("книги"), "many": xs("книг")})}, xs("книги")},
+		{"plural", rus, []types.XValue{xi(11), types.NewXObject(map[string]types.XValue{"one": xs("книга"), "few": xs("книги"), "many": xs("книг")})}, xs("книг")},
+		{"plural", rus, []types.XValue{xn("1.5"), types.NewXObject(map[string]types.XValue{"one": xs("книга"), "other": xs("книги")})}, xs("книги")},
+		{"plural", rus, []types.XValue{xn("1.5"), types.NewXObject(map[string]types.XValue{"one": xs("книга")})}, ERROR},
+		{"plural", dmy, []types.XValue{xi(2), xs("items")}, ERROR},
+		{"plural", dmy, []types.XValue{xs("x"), xs("item"), xs("items")}, ERROR},
+		{"plural", dmy, []types.XValue{xi(1), ERROR, xs("items")}, ERROR},
+		{"plural", dmy, []types.XValue{xi(1)}, ERROR},
+
 		{"percent", dmy, []types.XValue{xs(".54")}, xs("54%")},
 		{"percent", dmy, []types.XValue{xs("1.246")}, xs("125%")},
 		{"percent", dmy, []types.XValue{xs("")}, ERROR},
@@ -468,6 +640,13 @@ func TestFunctions(t *testing.T) {
 		{"read_chars", dmy, []types.XValue{xs("12")}, xs("1 , 2")},
 		{"read_chars", dmy, []types.XValue{}, ERROR},
 
+		{"reduce", dmy, []types.XValue{xa(xi(1), xi(5), xi(3)), xf("max"), xi(0)}, xi(5)},
+		{"reduce", dmy, []types.XValue{xa(), xf("max"), xi(7)}, xi(7)},
+		{"reduce", dmy, []types.XValue{ERROR, xf("max"), xi(0)}, ERROR},
+		{"reduce", dmy, []types.XValue{xa(xi(1), xi(5)), ERROR, xi(0)}, ERROR},
+		{"reduce", dmy, []types.XValue{xa(xs("a"), xs("b")), xf("max"), xi(0)}, ERROR},
+		{"reduce", dmy, []types.XValue{xa(xi(1)), xf("max")}, ERROR},
+
 		{"regex_match", dmy, []types.XValue{xs("zAbc"), xs(`a\w`)}, xs(`Ab`)},
 		{"regex_match", dmy, []types.XValue{xs("<html>"), xs(`<(\w+)>`), xn("1")}, xs(`html`)},
 		{"regex_match", dmy, []types.XValue{xs("<html>"), xs(`<(\w+)>`), xn("2")}, ERROR}, // invalid group
@@ -555,6 +734,41 @@ func TestFunctions(t *testing.T) {
 		{"round_up", dmy, []types.XValue{xs("not_num")}, ERROR},
 		{"round_up", dmy, []types.XValue{}, ERROR},
 
+		{"set_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), xs("b"), xa(xi(2))}, types.NewXObject(map[string]types.XValue{"a": xi(1), "b": xa(xi(2))})},
+		{"set_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), xs("A"), xi(2)}, types.NewXObject(map[string]types.XValue{"A": xi(2)})},
+		{"set_key", dmy, []types.XValue{nil, xs("a"), xi(2)}, types.NewXObject(map[string]types.XValue{"a": xi(2)})},
+		{"set_key", dmy, []types.XValue{xs("abc"), xs("a"), xi(2)}, ERROR},
+		{"set_key", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"a": xi(1)}), ERROR, xi(2)}, ERROR},
+
+		{"slice", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xi(1)}, xa(xs("b"), xs("c"))},
+		{"slice", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xi(0), xi(2)}, xa(xs("a"), xs("b"))},
+		{"slice", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xi(-2), xi(-1)}, xa(xs("b"))},
+		{"slice", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xi(5)}, xa()},
+		{"slice", dmy, []types.XValue{xa(xs("a"), xs("b"), xs("c")), xi(-10), xi(10)}, xa(xs("a"), xs("b"), xs("c"))},
+		{"slice", dmy, []types.XValue{xa(xs("a")), xs("x")}, ERROR},
+		{"slice", dmy, []types.XValue{xa(xs("a")), xi(0), ERROR}, ERROR},
+		{"slice", dmy, []types.XValue{xs("abc"), xi(0)}, ERROR},
+		{"slice", dmy, []types.XValue{xa(xs("a"))}, ERROR},
+
+		{"sort", dmy, []types.XValue{xa(xi(10), xn("2.5"), xs("3"))}, xa(xi(10), xn("2.5"), xs("3"))},
+		{"sort", dmy, []types.XValue{xa(xs("b"), xs("C"), xs("a"))}, xa(xs("C"), xs("a"), xs("b"))},
+		{"sort", dmy, []types.XValue{xa(xi(10), xi(9))}, xa(xi(9), xi(10))},
+		{"sort", dmy, []types.XValue{xa()}, xa()},
+		{"sort", dmy, []types.XValue{xa(xi(1), ERROR)}, ERROR},
+		{"sort", dmy, []types.XValue{xs("abc")}, ERROR},
+
+		{"sort_by", dmy, []types.XValue{xa(xi(-3), xi(1), xi(2)), xf("abs")}, xa(xi(1), xi(2), xi(-3))},
+		{"sort_by", dmy, []types.XValue{xa(xs("bb"), xs("a"), xs("ccc")), xf("text_length")}, xa(xs("a"), xs("bb"), xs("ccc"))},
+		{"sort_by", dmy, []types.XValue{xa(xs("b"), xs("C"), xs("a")), xf("lower")}, xa(xs("a"), xs("b"), xs("C"))},
+		{"sort_by", dmy, []types.XValue{xa(xs("10"), xs("9")), xf("text")}, xa(xs("10"), xs("9"))},
+		{"sort_by", dmy, []types.XValue{xa(xs("10"), xs("9")), xf("number")}, xa(xs("9"), xs("10"))},
+		{"sort_by", dmy, []types.XValue{xa(xd(dates.NewDate(2020, 3, 1)), xd(dates.NewDate(2019, 3, 1))), xf("date")}, xa(xd(dates.NewDate(2019, 3, 1)), xd(dates.NewDate(2020, 3, 1)))},
+		{"sort_by", dmy, []types.XValue{xa(), xf("text")}, xa()},
+		{"sort_by", dmy, []types.XValue{ERROR, xf("text")}, ERROR},
+		{"sort_by", dmy, []types.XValue{xa(xs("a"), xs("b")), ERROR}, ERROR},
+		{"sort_by", dmy, []types.XValue{xa(xs("a"), xs("b")), xf("abs")}, ERROR},
+		{"sort_by", dmy, []types.XValue{xa(xs("a"))}, ERROR},
+
 		{"split", dmy, []types.XValue{xs("1 2   3")}, xa(xs("1"), xs("2"), xs("3"))},
 		{"split", dmy, []types.XValue{xs("1 2,3"), nil}, xa(xs("1"), xs("2"), xs("3"))},
 		{"split", dmy, []types.XValue{xs("1,2,3"), xs(",")}, xa(xs("1"), xs("2"), xs("3"))},
@@ -563,6 +777,17 @@ func TestFunctions(t *testing.T) {
 		{"split", dmy, []types.XValue{ERROR, xs(",")}, ERROR},
 		{"split", dmy, []types.XValue{}, ERROR},
 
+		{"sha256", dmy, []types.XValue{xs("Hello World")}, xs("a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e")},
+		{"sha256", dmy, []types.XValue{xs("")}, xs("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")},
+		{"sha256", dmy, []types.XValue{ERROR}, ERROR},
+		{"sha256", dmy, []types.XValue{}, ERROR},
+
+		{"sum", dmy, []types.XValue{xa(xi(1), xn("2.5"), xs("3"))}, xn("6.5")},
+		{"sum", dmy, []types.XValue{xa()}, xi(0)},
+		{"sum", dmy, []types.XValue{xa(xi(1), xs("x"))}, ERROR},
+		{"sum", dmy, []types.XValue{xs("abc")}, ERROR},
+		{"sum", dmy, []types.XValue{ERROR}, ERROR},
+
 		{"text", dmy, []types.XValue{xs("abc")}, xs("abc")},
 		{"text", dmy, []types.XValue{xi(123)}, xs("123")},
 		{"text", dmy, []types.XValue{ERROR}, ERROR},
@@ -634,6 +859,13 @@ func TestFunctions(t *testing.T) {
 		{"tz_offset", dmy, []types.XValue{xs("xxx")}, ERROR},
 		{"tz_offset", dmy, []types.XValue{}, ERROR},
 
+		{"unique", dmy, []types.XValue{xa(xs("a"), xi(1), xs("a"), xs("1"), xi(1))}, xa(xs("a"), xi(1))},
+		{"unique", dmy, []types.XValue{xa(xs("a"), xs("A"), nil, xs(""))}, xa(xs("a"), xs("A"), nil)},
+		{"unique", dmy, []types.XValue{xa(xi(1), ERROR)}, ERROR},
+		{"unique", dmy, []types.XValue{xa()}, xa()},
+		{"unique", dmy, []types.XValue{xs("abc")}, ERROR},
+		{"unique", dmy, []types.XValue{ERROR}, ERROR},
+
 		{"upper", dmy, []types.XValue{xs("HEllo")}, xs("HELLO")},
 		{"upper", dmy, []types.XValue{xs("  HELLO  world")}, xs("  HELLO  WORLD")},
 		{"upper", dmy, []types.XValue{xs("ß")}, xs("ß")},
@@ -701,15 +933,31 @@ func TestFunctions(t *testing.T) {
 		{"week_number", dmy, []types.XValue{xs("xxx")}, ERROR},
 		{"week_number", dmy, []types.XValue{}, ERROR},
 
+		{"url_decode", dmy, []types.XValue{xs(`hi-%25%20%3F%2F`)}, xs(`hi-% ?/`)},
+		{"url_decode", dmy, []types.XValue{xs(`a+b`)}, xs(`a b`)},
+		{"url_decode", dmy, []types.XValue{xs(`%zz`)}, ERROR},
+		{"url_decode", dmy, []types.XValue{ERROR}, ERROR},
+		{"url_decode", dmy, []types.XValue{}, ERROR},
+
 		{"url_encode", dmy, []types.XValue{xs(`hi-% ?/`)}, xs(`hi-%25%20%3F%2F`)},
 		{"url_encode", dmy, []types.XValue{ERROR}, ERROR},
 		{"url_encode", dmy, []types.XValue{}, ERROR},
+
+		{"values", dmy, []types.XValue{types.NewXObject(map[string]types.XValue{"B": xi(1), "a": xi(2)})}, xa(xi(1), xi(2))},
+		{"values", dmy, []types.XValue{nil}, xa()},
+		{"values", dmy, []types.XValue{xa(xi(1))}, ERROR},
+		{"values", dmy, []types.XValue{ERROR}, ERROR},
+
+		{"uuid", dmy, []types.XValue{}, xs("d2f852ec-7b4e-457f-ae7f-f8b243c49ff5")},
+		{"uuid", dmy, []types.XValue{xs("x")}, ERROR},
 	}
 
 	defer random.SetGenerator(random.DefaultGenerator)
+	defer uuids.SetGenerator(uuids.DefaultGenerator)
 	defer dates.SetNowSource(dates.DefaultNowSource)
 
 	random.SetGenerator(random.NewSeededGenerator(123456))
+	uuids.SetGenerator(uuids.NewSeededGenerator(123456))
 	dates.SetNowSource(dates.NewFixedNowSource(time.Date(2018, 4, 11, 13, 24, 30, 123456000, time.UTC)))
 
 	for _, tc := range funcTests {
@@ -728,3 +976,17 @@ func TestFunctions(t *testing.T) {
 		}
 	}
 }
+
+func TestParamTypes(t *testing.T) {
+	assert.Equal(t, functions.ParamTypeNumber, functions.LookupParamType("abs", 0))
+	assert.Equal(t, functions.ParamTypeNumber, functions.LookupParamType("ROUND", 1))
+	assert.Equal(t, functions.ParamTypeAny, functions.LookupParamType("word", 0))
+	assert.Equal(t, functions.ParamTypeFunction, functions.LookupParamType("sort_by", 1))
+	assert.Equal(t, functions.ParamTypeAny, functions.LookupParamType("sort_by", 2))
+	assert.Equal(t, functions.ParamTypeAny, functions.LookupParamType("upper", 0))
+
+	// every function with declared parameter types must be registered
+	for name := range functions.XFUNCTION_PARAM_TYPES {
+		assert.NotNil(t, functions.Lookup(name), "param types declared for unknown function %s", name)
+	}
+}
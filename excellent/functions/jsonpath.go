@@ -0,0 +1,248 @@
+package functions
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/goflow/excellent/types"
+
+	"github.com/pkg/errors"
+)
+
+type jsonPathSelector int
+
+const (
+	jsonPathKey jsonPathSelector = iota
+	jsonPathWildcard
+	jsonPathIndex
+	jsonPathSlice
+)
+
+// a single step in a JSONPath expression, e.g. .foo or [2] or ..bar
+type jsonPathStep struct {
+	selector  jsonPathSelector
+	recursive bool
+	key       string
+	index     int
+	start     *int
+	end       *int
+}
+
+// parses a JSONPath expression like $.results[*].name into steps. The leading $ is optional.
+func parseJSONPath(path string) ([]*jsonPathStep, error) {
+	steps := make([]*jsonPathStep, 0)
+	p := strings.TrimSpace(path)
+	p = strings.TrimPrefix(p, "$")
+
+	// allow paths like foo.bar without a leading dot
+	if p != "" && p[0] != '.' && p[0] != '[' {
+		p = "." + p
+	}
+
+	for len(p) > 0 {
+		recursive := false
+
+		if strings.HasPrefix(p, "..") {
+			recursive = true
+			p = p[2:]
+			if strings.HasPrefix(p, "[") {
+				step, rest, err := parseJSONPathBracket(p)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid JSON path %s", path)
+				}
+				step.recursive = true
+				steps = append(steps, step)
+				p = rest
+				continue
+			}
+		} else if p[0] == '.' {
+			p = p[1:]
+		} else if p[0] == '[' {
+			step, rest, err := parseJSONPathBracket(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid JSON path %s", path)
+			}
+			steps = append(steps, step)
+			p = rest
+			continue
+		}
+
+		// read a dotted name up to the next separator
+		end := strings.IndexAny(p, ".[")
+		if end < 0 {
+			end = len(p)
+		}
+		name := p[:end]
+		p = p[end:]
+
+		if name == "" {
+			return nil, errors.Errorf("invalid JSON path %s", path)
+		}
+		if name == "*" {
+			steps = append(steps, &jsonPathStep{selector: jsonPathWildcard, recursive: recursive})
+		} else {
+			steps = append(steps, &jsonPathStep{selector: jsonPathKey, recursive: recursive, key: name})
+		}
+	}
+
+	return steps, nil
+}
+
+// parses a bracketed step like [0], [*], ['foo'] or [1:3] from the start of the given path
+func parseJSONPathBracket(p string) (*jsonPathStep, string, error) {
+	closing := strings.IndexByte(p, ']')
+
+	// quoted keys may contain a ]
+	if len(p) > 1 && (p[1] == '\'' || p[1] == '"') {
+		quote := p[1]
+		endQuote := strings.IndexByte(p[2:], quote)
+		if endQuote < 0 || len(p) < endQuote+4 || p[endQuote+3] != ']' {
+			return nil, "", errors.New("unclosed quoted key")
+		}
+		return &jsonPathStep{selector: jsonPathKey, key: p[2 : endQuote+2]}, p[endQuote+4:], nil
+	}
+
+	if closing < 0 {
+		return nil, "", errors.New("unclosed bracket")
+	}
+
+	inner := strings.TrimSpace(p[1:closing])
+	rest := p[closing+1:]
+
+	if inner == "*" {
+		return &jsonPathStep{selector: jsonPathWildcard}, rest, nil
+	}
+
+	if strings.Contains(inner, ":") {
+		parts := strings.SplitN(inner, ":", 2)
+		step := &jsonPathStep{selector: jsonPathSlice}
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, "", errors.Errorf("invalid slice bound %s", part)
+			}
+			if i == 0 {
+				step.start = &n
+			} else {
+				step.end = &n
+			}
+		}
+		return step, rest, nil
+	}
+
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return nil, "", errors.Errorf("invalid index %s", inner)
+	}
+	return &jsonPathStep{selector: jsonPathIndex, index: index}, rest, nil
+}
+
+// evaluates the given steps against a value, returning all matching values
+func evaluateJSONPath(root types.XValue, steps []*jsonPathStep) []types.XValue {
+	nodes := []types.XValue{root}
+
+	for _, step := range steps {
+		matches := make([]types.XValue, 0)
+
+		for _, node := range nodes {
+			candidates := []types.XValue{node}
+			if step.recursive {
+				candidates = jsonPathDescendants(node, nil)
+			}
+			for _, candidate := range candidates {
+				matches = step.apply(candidate, matches)
+			}
+		}
+
+		nodes = matches
+	}
+
+	return nodes
+}
+
+// applies this step to the given value, appending any matches to the given slice
+func (s *jsonPathStep) apply(value types.XValue, matches []types.XValue) []types.XValue {
+	switch typed := value.(type) {
+	case *types.XObject:
+		switch s.selector {
+		case jsonPathKey:
+			if v, exists := typed.Get(s.key); exists {
+				matches = append(matches, v)
+			}
+		case jsonPathWildcard:
+			for _, prop := range typed.Properties() {
+				v, _ := typed.Get(prop)
+				matches = append(matches, v)
+			}
+		}
+	case *types.XArray:
+		switch s.selector {
+		case jsonPathWildcard:
+			for i := 0; i < typed.Count(); i++ {
+				matches = append(matches, typed.Get(i))
+			}
+		case jsonPathIndex:
+			index := s.index
+			if index < 0 {
+				index += typed.Count()
+			}
+			if index >= 0 && index < typed.Count() {
+				matches = append(matches, typed.Get(index))
+			}
+		case jsonPathSlice:
+			start, end := sliceBounds(typed.Count(), s.start, s.end)
+			for i := start; i < end; i++ {
+				matches = append(matches, typed.Get(i))
+			}
+		}
+	}
+	return matches
+}
+
+// collects the given value and all values nested inside it, in document order
+func jsonPathDescendants(value types.XValue, all []types.XValue) []types.XValue {
+	all = append(all, value)
+
+	switch typed := value.(type) {
+	case *types.XObject:
+		for _, prop := range typed.Properties() {
+			v, _ := typed.Get(prop)
+			all = jsonPathDescendants(v, all)
+		}
+	case *types.XArray:
+		for i := 0; i < typed.Count(); i++ {
+			all = jsonPathDescendants(typed.Get(i), all)
+		}
+	}
+	return all
+}
+
+// resolves optional start and end indexes, which may be negative, into a range within a sequence of the given length
+func sliceBounds(length int, start, end *int) (int, int) {
+	resolve := func(i *int, def int) int {
+		if i == nil {
+			return def
+		}
+		n := *i
+		if n < 0 {
+			n += length
+		}
+		if n < 0 {
+			return 0
+		}
+		if n > length {
+			return length
+		}
+		return n
+	}
+
+	s, e := resolve(start, 0), resolve(end, length)
+	if e < s {
+		e = s
+	}
+	return s, e
+}
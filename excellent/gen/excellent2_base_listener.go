@@ -104,6 +104,42 @@ func (s *BaseExcellent2Listener) EnterExponent(ctx *ExponentContext) {}
 // ExitExponent is called when production exponent is exited.
 func (s *BaseExcellent2Listener) ExitExponent(ctx *ExponentContext) {}
 
+// EnterAnonFunction is called when production anonFunction is entered.
+func (s *BaseExcellent2Listener) EnterAnonFunction(ctx *AnonFunctionContext) {}
+
+// ExitAnonFunction is called when production anonFunction is exited.
+func (s *BaseExcellent2Listener) ExitAnonFunction(ctx *AnonFunctionContext) {}
+
+// EnterNot is called when production not is entered.
+func (s *BaseExcellent2Listener) EnterNot(ctx *NotContext) {}
+
+// ExitNot is called when production not is exited.
+func (s *BaseExcellent2Listener) ExitNot(ctx *NotContext) {}
+
+// EnterAnd is called when production and is entered.
+func (s *BaseExcellent2Listener) EnterAnd(ctx *AndContext) {}
+
+// ExitAnd is called when production and is exited.
+func (s *BaseExcellent2Listener) ExitAnd(ctx *AndContext) {}
+
+// EnterOr is called when production or is entered.
+func (s *BaseExcellent2Listener) EnterOr(ctx *OrContext) {}
+
+// ExitOr is called when production or is exited.
+func (s *BaseExcellent2Listener) ExitOr(ctx *OrContext) {}
+
+// EnterCoalesce is called when production coalesce is entered.
+func (s *BaseExcellent2Listener) EnterCoalesce(ctx *CoalesceContext) {}
+
+// ExitCoalesce is called when production coalesce is exited.
+func (s *BaseExcellent2Listener) ExitCoalesce(ctx *CoalesceContext) {}
+
+// EnterConditional is called when production conditional is entered.
+func (s *BaseExcellent2Listener) EnterConditional(ctx *ConditionalContext) {}
+
+// ExitConditional is called when production conditional is exited.
+func (s *BaseExcellent2Listener) ExitConditional(ctx *ConditionalContext) {}
+
 // EnterParentheses is called when production parentheses is entered.
 func (s *BaseExcellent2Listener) EnterParentheses(ctx *ParenthesesContext) {}
 
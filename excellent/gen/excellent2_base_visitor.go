@@ -63,6 +63,30 @@ func (v *BaseExcellent2Visitor) VisitExponent(ctx *ExponentContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseExcellent2Visitor) VisitAnonFunction(ctx *AnonFunctionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseExcellent2Visitor) VisitNot(ctx *NotContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseExcellent2Visitor) VisitAnd(ctx *AndContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseExcellent2Visitor) VisitOr(ctx *OrContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseExcellent2Visitor) VisitCoalesce(ctx *CoalesceContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseExcellent2Visitor) VisitConditional(ctx *ConditionalContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseExcellent2Visitor) VisitParentheses(ctx *ParenthesesContext) interface{} {
 	return v.VisitChildren(ctx)
 }
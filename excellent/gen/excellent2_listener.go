@@ -49,6 +49,24 @@ type Excellent2Listener interface {
 	// EnterExponent is called when entering the exponent production.
 	EnterExponent(c *ExponentContext)
 
+	// EnterAnonFunction is called when entering the anonFunction production.
+	EnterAnonFunction(c *AnonFunctionContext)
+
+	// EnterNot is called when entering the not production.
+	EnterNot(c *NotContext)
+
+	// EnterAnd is called when entering the and production.
+	EnterAnd(c *AndContext)
+
+	// EnterOr is called when entering the or production.
+	EnterOr(c *OrContext)
+
+	// EnterCoalesce is called when entering the coalesce production.
+	EnterCoalesce(c *CoalesceContext)
+
+	// EnterConditional is called when entering the conditional production.
+	EnterConditional(c *ConditionalContext)
+
 	// EnterParentheses is called when entering the parentheses production.
 	EnterParentheses(c *ParenthesesContext)
 
@@ -109,6 +127,24 @@ type Excellent2Listener interface {
 	// ExitExponent is called when exiting the exponent production.
 	ExitExponent(c *ExponentContext)
 
+	// ExitAnonFunction is called when exiting the anonFunction production.
+	ExitAnonFunction(c *AnonFunctionContext)
+
+	// ExitNot is called when exiting the not production.
+	ExitNot(c *NotContext)
+
+	// ExitAnd is called when exiting the and production.
+	ExitAnd(c *AndContext)
+
+	// ExitOr is called when exiting the or production.
+	ExitOr(c *OrContext)
+
+	// ExitCoalesce is called when exiting the coalesce production.
+	ExitCoalesce(c *CoalesceContext)
+
+	// ExitConditional is called when exiting the conditional production.
+	ExitConditional(c *ConditionalContext)
+
 	// ExitParentheses is called when exiting the parentheses production.
 	ExitParentheses(c *ParenthesesContext)
 
@@ -15,55 +15,71 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 29, 83, 4,
-	2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 3, 2, 3, 2, 3, 2, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 23, 10, 3, 3, 3, 3,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 36, 113,
+	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 3, 2, 3, 2, 3, 2, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 23, 10, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 43, 10, 3, 12, 3, 14, 3, 46, 11, 3, 3,
-	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 54, 10, 4, 3, 4, 3, 4, 3, 4, 5,
-	4, 59, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 7,
-	4, 70, 10, 4, 12, 4, 14, 4, 73, 11, 4, 3, 5, 3, 5, 3, 5, 7, 5, 78, 10,
-	5, 12, 5, 14, 5, 81, 11, 5, 3, 5, 2, 4, 4, 6, 6, 2, 4, 6, 8, 2, 8, 3, 2,
-	22, 23, 3, 2, 11, 12, 3, 2, 9, 10, 3, 2, 16, 19, 3, 2, 14, 15, 4, 2, 22,
-	22, 27, 27, 2, 96, 2, 10, 3, 2, 2, 2, 4, 22, 3, 2, 2, 2, 6, 53, 3, 2, 2,
-	2, 8, 74, 3, 2, 2, 2, 10, 11, 5, 4, 3, 2, 11, 12, 7, 2, 2, 3, 12, 3, 3,
-	2, 2, 2, 13, 14, 8, 3, 1, 2, 14, 23, 5, 6, 4, 2, 15, 16, 7, 10, 2, 2, 16,
-	23, 5, 4, 3, 14, 17, 23, 7, 21, 2, 2, 18, 23, 9, 2, 2, 2, 19, 23, 7, 24,
-	2, 2, 20, 23, 7, 25, 2, 2, 21, 23, 7, 26, 2, 2, 22, 13, 3, 2, 2, 2, 22,
-	15, 3, 2, 2, 2, 22, 17, 3, 2, 2, 2, 22, 18, 3, 2, 2, 2, 22, 19, 3, 2, 2,
-	2, 22, 20, 3, 2, 2, 2, 22, 21, 3, 2, 2, 2, 23, 44, 3, 2, 2, 2, 24, 25,
-	12, 13, 2, 2, 25, 26, 7, 13, 2, 2, 26, 43, 5, 4, 3, 14, 27, 28, 12, 12,
-	2, 2, 28, 29, 9, 3, 2, 2, 29, 43, 5, 4, 3, 13, 30, 31, 12, 11, 2, 2, 31,
-	32, 9, 4, 2, 2, 32, 43, 5, 4, 3, 12, 33, 34, 12, 10, 2, 2, 34, 35, 9, 5,
-	2, 2, 35, 43, 5, 4, 3, 11, 36, 37, 12, 9, 2, 2, 37, 38, 9, 6, 2, 2, 38,
-	43, 5, 4, 3, 10, 39, 40, 12, 8, 2, 2, 40, 41, 7, 20, 2, 2, 41, 43, 5, 4,
-	3, 9, 42, 24, 3, 2, 2, 2, 42, 27, 3, 2, 2, 2, 42, 30, 3, 2, 2, 2, 42, 33,
-	3, 2, 2, 2, 42, 36, 3, 2, 2, 2, 42, 39, 3, 2, 2, 2, 43, 46, 3, 2, 2, 2,
-	44, 42, 3, 2, 2, 2, 44, 45, 3, 2, 2, 2, 45, 5, 3, 2, 2, 2, 46, 44, 3, 2,
-	2, 2, 47, 48, 8, 4, 1, 2, 48, 49, 7, 4, 2, 2, 49, 50, 5, 4, 3, 2, 50, 51,
-	7, 5, 2, 2, 51, 54, 3, 2, 2, 2, 52, 54, 7, 27, 2, 2, 53, 47, 3, 2, 2, 2,
-	53, 52, 3, 2, 2, 2, 54, 71, 3, 2, 2, 2, 55, 56, 12, 7, 2, 2, 56, 58, 7,
-	4, 2, 2, 57, 59, 5, 8, 5, 2, 58, 57, 3, 2, 2, 2, 58, 59, 3, 2, 2, 2, 59,
-	60, 3, 2, 2, 2, 60, 70, 7, 5, 2, 2, 61, 62, 12, 6, 2, 2, 62, 63, 7, 8,
-	2, 2, 63, 70, 9, 7, 2, 2, 64, 65, 12, 5, 2, 2, 65, 66, 7, 6, 2, 2, 66,
-	67, 5, 4, 3, 2, 67, 68, 7, 7, 2, 2, 68, 70, 3, 2, 2, 2, 69, 55, 3, 2, 2,
-	2, 69, 61, 3, 2, 2, 2, 69, 64, 3, 2, 2, 2, 70, 73, 3, 2, 2, 2, 71, 69,
-	3, 2, 2, 2, 71, 72, 3, 2, 2, 2, 72, 7, 3, 2, 2, 2, 73, 71, 3, 2, 2, 2,
-	74, 79, 5, 4, 3, 2, 75, 76, 7, 3, 2, 2, 76, 78, 5, 4, 3, 2, 77, 75, 3,
-	2, 2, 2, 78, 81, 3, 2, 2, 2, 79, 77, 3, 2, 2, 2, 79, 80, 3, 2, 2, 2, 80,
-	9, 3, 2, 2, 2, 81, 79, 3, 2, 2, 2, 10, 22, 42, 44, 53, 58, 69, 71, 79,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 43, 10, 3, 12, 3, 14, 3, 46, 11, 3,
+	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 54, 10, 4, 3, 4, 3, 4, 3, 4,
+	5, 4, 59, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
+	7, 4, 70, 10, 4, 12, 4, 14, 4, 73, 11, 4, 3, 5, 3, 5, 3, 5, 7, 5, 78,
+	10, 5, 12, 5, 14, 5, 81, 11, 5, 3, 5, 3, 3, 3, 3, 3, 3, 7, 3, 87, 10, 3,
+	12, 3, 14, 3, 90, 11, 3, 3, 3, 5, 3, 93, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 4, 4, 6, 6, 2, 4, 6, 8, 2, 9, 3, 2, 26, 27, 3, 2,
+	11, 12, 3, 2, 9, 10, 3, 2, 16, 19, 3, 2, 14, 15, 4, 2, 26, 26, 31, 34,
+	4, 2, 31, 32, 34, 34, 2, 134, 2, 10, 3, 2, 2, 2, 4, 22, 3, 2, 2, 2, 6,
+	53, 3, 2, 2, 2, 8, 74, 3, 2, 2, 2, 10, 11, 5, 4, 3, 2, 11, 12, 7, 2, 2,
+	3, 12, 3, 3, 2, 2, 2, 13, 14, 8, 3, 1, 2, 14, 23, 5, 6, 4, 2, 15, 16, 7,
+	10, 2, 2, 16, 23, 5, 4, 3, 20, 17, 23, 7, 25, 2, 2, 18, 23, 9, 2, 2, 2,
+	19, 23, 7, 28, 2, 2, 20, 23, 7, 29, 2, 2, 21, 23, 7, 30, 2, 2, 22, 13,
+	3, 2, 2, 2, 22, 15, 3, 2, 2, 2, 22, 97, 3, 2, 2, 2, 22, 17, 3, 2, 2, 2,
+	22, 18, 3, 2, 2, 2, 22, 19, 3, 2, 2, 2, 22, 20, 3, 2, 2, 2, 22, 21, 3,
+	2, 2, 2, 23, 44, 3, 2, 2, 2, 24, 25, 12, 19, 2, 2, 25, 26, 7, 13, 2, 2,
+	26, 43, 5, 4, 3, 20, 27, 28, 12, 18, 2, 2, 28, 29, 9, 3, 2, 2, 29, 43,
+	5, 4, 3, 19, 30, 31, 12, 17, 2, 2, 31, 32, 9, 4, 2, 2, 32, 43, 5, 4, 3,
+	18, 33, 34, 12, 16, 2, 2, 34, 35, 9, 5, 2, 2, 35, 43, 5, 4, 3, 17, 36,
+	37, 12, 15, 2, 2, 37, 38, 9, 6, 2, 2, 38, 43, 5, 4, 3, 16, 39, 40, 12,
+	14, 2, 2, 40, 41, 7, 20, 2, 2, 41, 43, 5, 4, 3, 15, 42, 24, 3, 2, 2, 2,
+	42, 27, 3, 2, 2, 2, 42, 30, 3, 2, 2, 2, 42, 33, 3, 2, 2, 2, 42, 36, 3,
+	2, 2, 2, 42, 39, 3, 2, 2, 2, 43, 46, 3, 2, 2, 2, 44, 42, 3, 2, 2, 2, 44,
+	45, 3, 2, 2, 2, 45, 5, 3, 2, 2, 2, 46, 44, 3, 2, 2, 2, 47, 48, 8, 4, 1,
+	2, 48, 49, 7, 4, 2, 2, 49, 50, 5, 4, 3, 2, 50, 51, 7, 5, 2, 2, 51, 54,
+	3, 2, 2, 2, 52, 54, 9, 8, 2, 2, 53, 47, 3, 2, 2, 2, 53, 52, 3, 2, 2, 2,
+	54, 71, 3, 2, 2, 2, 55, 56, 12, 7, 2, 2, 56, 58, 7, 4, 2, 2, 57, 59, 5,
+	8, 5, 2, 58, 57, 3, 2, 2, 2, 58, 59, 3, 2, 2, 2, 59, 60, 3, 2, 2, 2, 60,
+	70, 7, 5, 2, 2, 61, 62, 12, 6, 2, 2, 62, 63, 7, 8, 2, 2, 63, 70, 9, 7,
+	2, 2, 64, 65, 12, 5, 2, 2, 65, 66, 7, 6, 2, 2, 66, 67, 5, 4, 3, 2, 67,
+	68, 7, 7, 2, 2, 68, 70, 3, 2, 2, 2, 69, 55, 3, 2, 2, 2, 69, 61, 3, 2, 2,
+	2, 69, 64, 3, 2, 2, 2, 70, 73, 3, 2, 2, 2, 71, 69, 3, 2, 2, 2, 71, 72,
+	3, 2, 2, 2, 72, 7, 3, 2, 2, 2, 73, 71, 3, 2, 2, 2, 74, 79, 5, 4, 3, 2,
+	75, 76, 7, 3, 2, 2, 76, 78, 5, 4, 3, 2, 77, 75, 3, 2, 2, 2, 78, 81, 3,
+	2, 2, 2, 79, 77, 3, 2, 2, 2, 79, 80, 3, 2, 2, 2, 80, 9, 3, 2, 2, 2, 81,
+	79, 3, 2, 2, 2, 83, 92, 7, 4, 2, 2, 84, 85, 7, 3, 2, 2, 85, 87, 7, 34,
+	2, 2, 86, 84, 3, 2, 2, 2, 87, 90, 3, 2, 2, 2, 88, 86, 3, 2, 2, 2, 88,
+	89, 3, 2, 2, 2, 89, 93, 3, 2, 2, 2, 90, 88, 3, 2, 2, 2, 91, 88, 7, 34,
+	2, 2, 92, 91, 3, 2, 2, 2, 92, 93, 3, 2, 2, 2, 93, 94, 3, 2, 2, 2, 94,
+	95, 7, 5, 2, 2, 95, 96, 7, 21, 2, 2, 96, 23, 5, 4, 3, 3, 22, 83, 3, 2,
+	2, 2, 97, 98, 7, 33, 2, 2, 98, 23, 5, 4, 3, 13, 99, 100, 12, 12, 2, 2,
+	100, 101, 7, 31, 2, 2, 101, 43, 5, 4, 3, 13, 102, 103, 12, 11, 2, 2,
+	103, 104, 7, 32, 2, 2, 104, 43, 5, 4, 3, 12, 105, 106, 12, 10, 2, 2,
+	106, 107, 7, 22, 2, 2, 107, 43, 5, 4, 3, 11, 108, 109, 12, 9, 2, 2, 109,
+	110, 7, 23, 2, 2, 110, 111, 5, 4, 3, 2, 111, 112, 7, 24, 2, 2, 112, 43,
+	5, 4, 3, 9, 42, 99, 3, 2, 2, 2, 42, 102, 3, 2, 2, 2, 42, 105, 3, 2, 2,
+	2, 42, 108, 3, 2, 2, 2, 12, 22, 42, 44, 53, 58, 69, 71, 79, 92, 88,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "','", "'('", "')'", "'['", "']'", "'.'", "'+'", "'-'", "'*'", "'/'",
-	"'^'", "'='", "'!='", "'<='", "'<'", "'>='", "'>'", "'&'",
+	"", "','", "'('", "')'", "'['", "']'", "'.'", "'+'", "'-'", "'*'", "'/'", "'^'",
+	"'='", "'!='", "'<='", "'<'", "'>='", "'>'", "'&'", "'=>'", "'??'", "'?'", "':'",
 }
 var symbolicNames = []string{
 	"", "COMMA", "LPAREN", "RPAREN", "LBRACK", "RBRACK", "DOT", "PLUS", "MINUS",
-	"TIMES", "DIVIDE", "EXPONENT", "EQ", "NEQ", "LTE", "LT", "GTE", "GT", "AMPERSAND",
-	"TEXT", "INTEGER", "DECIMAL", "TRUE", "FALSE", "NULL", "NAME", "WS", "ERROR",
+	"TIMES", "DIVIDE", "EXPONENT", "EQ", "NEQ", "LTE", "LT", "GTE", "GT",
+	"AMPERSAND", "ARROW", "QUESTIONS", "QUESTION", "COLON", "TEXT", "INTEGER",
+	"DECIMAL", "TRUE", "FALSE", "NULL", "AND", "OR", "NOT", "NAME", "WS", "ERROR",
 }
 
 var ruleNames = []string{
@@ -116,15 +132,22 @@ const (
 	Excellent2ParserGTE       = 16
 	Excellent2ParserGT        = 17
 	Excellent2ParserAMPERSAND = 18
-	Excellent2ParserTEXT      = 19
-	Excellent2ParserINTEGER   = 20
-	Excellent2ParserDECIMAL   = 21
-	Excellent2ParserTRUE      = 22
-	Excellent2ParserFALSE     = 23
-	Excellent2ParserNULL      = 24
-	Excellent2ParserNAME      = 25
-	Excellent2ParserWS        = 26
-	Excellent2ParserERROR     = 27
+	Excellent2ParserARROW     = 19
+	Excellent2ParserQUESTIONS = 20
+	Excellent2ParserQUESTION  = 21
+	Excellent2ParserCOLON     = 22
+	Excellent2ParserTEXT      = 23
+	Excellent2ParserINTEGER   = 24
+	Excellent2ParserDECIMAL   = 25
+	Excellent2ParserTRUE      = 26
+	Excellent2ParserFALSE     = 27
+	Excellent2ParserNULL      = 28
+	Excellent2ParserAND       = 29
+	Excellent2ParserOR        = 30
+	Excellent2ParserNOT       = 31
+	Excellent2ParserNAME      = 32
+	Excellent2ParserWS        = 33
+	Excellent2ParserERROR     = 34
 )
 
 // Excellent2Parser rules.
@@ -1074,6 +1097,410 @@ func (s *ExponentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type AnonFunctionContext struct {
+	*ExpressionContext
+}
+
+func NewAnonFunctionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AnonFunctionContext {
+	var p = new(AnonFunctionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AnonFunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AnonFunctionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserLPAREN, 0)
+}
+
+func (s *AnonFunctionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserRPAREN, 0)
+}
+
+func (s *AnonFunctionContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserARROW, 0)
+}
+
+func (s *AnonFunctionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AnonFunctionContext) AllNAME() []antlr.TerminalNode {
+	return s.GetTokens(Excellent2ParserNAME)
+}
+
+func (s *AnonFunctionContext) NAME(i int) antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserNAME, i)
+}
+
+func (s *AnonFunctionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(Excellent2ParserCOMMA)
+}
+
+func (s *AnonFunctionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserCOMMA, i)
+}
+
+func (s *AnonFunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterAnonFunction(s)
+	}
+}
+
+func (s *AnonFunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitAnonFunction(s)
+	}
+}
+
+func (s *AnonFunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitAnonFunction(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NotContext struct {
+	*ExpressionContext
+}
+
+func NewNotContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotContext {
+	var p = new(NotContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *NotContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NotContext) NOT() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserNOT, 0)
+}
+
+func (s *NotContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *NotContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterNot(s)
+	}
+}
+
+func (s *NotContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitNot(s)
+	}
+}
+
+func (s *NotContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitNot(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AndContext struct {
+	*ExpressionContext
+}
+
+func NewAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndContext {
+	var p = new(AndContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AndContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AndContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AndContext) AND() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserAND, 0)
+}
+
+func (s *AndContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterAnd(s)
+	}
+}
+
+func (s *AndContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitAnd(s)
+	}
+}
+
+func (s *AndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitAnd(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type OrContext struct {
+	*ExpressionContext
+}
+
+func NewOrContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrContext {
+	var p = new(OrContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *OrContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OrContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *OrContext) OR() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserOR, 0)
+}
+
+func (s *OrContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterOr(s)
+	}
+}
+
+func (s *OrContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitOr(s)
+	}
+}
+
+func (s *OrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitOr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CoalesceContext struct {
+	*ExpressionContext
+}
+
+func NewCoalesceContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CoalesceContext {
+	var p = new(CoalesceContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *CoalesceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CoalesceContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CoalesceContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *CoalesceContext) QUESTIONS() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserQUESTIONS, 0)
+}
+
+func (s *CoalesceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterCoalesce(s)
+	}
+}
+
+func (s *CoalesceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitCoalesce(s)
+	}
+}
+
+func (s *CoalesceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitCoalesce(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ConditionalContext struct {
+	*ExpressionContext
+}
+
+func NewConditionalContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConditionalContext {
+	var p = new(ConditionalContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ConditionalContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConditionalContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ConditionalContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ConditionalContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserQUESTION, 0)
+}
+
+func (s *ConditionalContext) COLON() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserCOLON, 0)
+}
+
+func (s *ConditionalContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.EnterConditional(s)
+	}
+}
+
+func (s *ConditionalContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(Excellent2Listener); ok {
+		listenerT.ExitConditional(s)
+	}
+}
+
+func (s *ConditionalContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case Excellent2Visitor:
+		return t.VisitConditional(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 func (p *Excellent2Parser) Expression() (localctx IExpressionContext) {
 	return p.expression(0)
 }
@@ -1110,8 +1537,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 	p.SetState(20)
 	p.GetErrorHandler().Sync(p)
 
-	switch p.GetTokenStream().LA(1) {
-	case Excellent2ParserLPAREN, Excellent2ParserNAME:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
+	case 1:
 		localctx = NewAtomReferenceContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1121,7 +1548,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			p.atom(0)
 		}
 
-	case Excellent2ParserMINUS:
+	case 2:
 		localctx = NewNegationContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1131,10 +1558,23 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 		}
 		{
 			p.SetState(14)
-			p.expression(12)
+			p.expression(18)
+		}
+
+	case 3:
+		localctx = NewNotContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(95)
+			p.Match(Excellent2ParserNOT)
+		}
+		{
+			p.SetState(96)
+			p.expression(11)
 		}
 
-	case Excellent2ParserTEXT:
+	case 4:
 		localctx = NewTextLiteralContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1143,7 +1583,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			p.Match(Excellent2ParserTEXT)
 		}
 
-	case Excellent2ParserINTEGER, Excellent2ParserDECIMAL:
+	case 5:
 		localctx = NewNumberLiteralContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1159,7 +1599,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 
-	case Excellent2ParserTRUE:
+	case 6:
 		localctx = NewTrueContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1168,7 +1608,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			p.Match(Excellent2ParserTRUE)
 		}
 
-	case Excellent2ParserFALSE:
+	case 7:
 		localctx = NewFalseContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1177,7 +1617,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			p.Match(Excellent2ParserFALSE)
 		}
 
-	case Excellent2ParserNULL:
+	case 8:
 		localctx = NewNullContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1186,8 +1626,56 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 			p.Match(Excellent2ParserNULL)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 9:
+		localctx = NewAnonFunctionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(81)
+			p.Match(Excellent2ParserLPAREN)
+		}
+		p.SetState(90)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == Excellent2ParserNAME {
+			{
+				p.SetState(89)
+				p.Match(Excellent2ParserNAME)
+			}
+			p.SetState(86)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == Excellent2ParserCOMMA {
+				{
+					p.SetState(82)
+					p.Match(Excellent2ParserCOMMA)
+				}
+				{
+					p.SetState(83)
+					p.Match(Excellent2ParserNAME)
+				}
+
+				p.SetState(88)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(92)
+			p.Match(Excellent2ParserRPAREN)
+		}
+		{
+			p.SetState(93)
+			p.Match(Excellent2ParserARROW)
+		}
+		{
+			p.SetState(94)
+			p.expression(1)
+		}
+
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
 	p.SetState(42)
@@ -1208,8 +1696,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(22)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
 				}
 				{
 					p.SetState(23)
@@ -1217,7 +1705,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(24)
-					p.expression(12)
+					p.expression(18)
 				}
 
 			case 2:
@@ -1225,8 +1713,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(25)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
 					p.SetState(26)
@@ -1248,7 +1736,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(27)
-					p.expression(11)
+					p.expression(17)
 				}
 
 			case 3:
@@ -1256,8 +1744,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(28)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
 				}
 				{
 					p.SetState(29)
@@ -1279,7 +1767,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(30)
-					p.expression(10)
+					p.expression(16)
 				}
 
 			case 4:
@@ -1287,8 +1775,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(31)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
 				}
 				{
 					p.SetState(32)
@@ -1310,7 +1798,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(33)
-					p.expression(9)
+					p.expression(15)
 				}
 
 			case 5:
@@ -1318,8 +1806,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(34)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 				}
 				{
 					p.SetState(35)
@@ -1341,7 +1829,7 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(36)
-					p.expression(8)
+					p.expression(14)
 				}
 
 			case 6:
@@ -1349,8 +1837,8 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
 				p.SetState(37)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 				}
 				{
 					p.SetState(38)
@@ -1358,6 +1846,82 @@ func (p *Excellent2Parser) expression(_p int) (localctx IExpressionContext) {
 				}
 				{
 					p.SetState(39)
+					p.expression(13)
+				}
+
+			case 7:
+				localctx = NewAndContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
+				p.SetState(97)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				}
+				{
+					p.SetState(98)
+					p.Match(Excellent2ParserAND)
+				}
+				{
+					p.SetState(99)
+					p.expression(11)
+				}
+
+			case 8:
+				localctx = NewOrContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
+				p.SetState(100)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				}
+				{
+					p.SetState(101)
+					p.Match(Excellent2ParserOR)
+				}
+				{
+					p.SetState(102)
+					p.expression(10)
+				}
+
+			case 9:
+				localctx = NewCoalesceContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
+				p.SetState(103)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				}
+				{
+					p.SetState(104)
+					p.Match(Excellent2ParserQUESTIONS)
+				}
+				{
+					p.SetState(105)
+					p.expression(9)
+				}
+
+			case 10:
+				localctx = NewConditionalContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, Excellent2ParserRULE_expression)
+				p.SetState(106)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				}
+				{
+					p.SetState(107)
+					p.Match(Excellent2ParserQUESTION)
+				}
+				{
+					p.SetState(108)
+					p.expression(0)
+				}
+				{
+					p.SetState(109)
+					p.Match(Excellent2ParserCOLON)
+				}
+				{
+					p.SetState(110)
 					p.expression(7)
 				}
 
@@ -1482,6 +2046,7 @@ func (s *ParenthesesContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 type DotLookupContext struct {
 	*AtomContext
+	property antlr.Token
 }
 
 func NewDotLookupContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DotLookupContext {
@@ -1494,6 +2059,10 @@ func NewDotLookupContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DotL
 	return p
 }
 
+func (s *DotLookupContext) GetProperty() antlr.Token { return s.property }
+
+func (s *DotLookupContext) SetProperty(v antlr.Token) { s.property = v }
+
 func (s *DotLookupContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1520,6 +2089,18 @@ func (s *DotLookupContext) INTEGER() antlr.TerminalNode {
 	return s.GetToken(Excellent2ParserINTEGER, 0)
 }
 
+func (s *DotLookupContext) AND() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserAND, 0)
+}
+
+func (s *DotLookupContext) OR() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserOR, 0)
+}
+
+func (s *DotLookupContext) NOT() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserNOT, 0)
+}
+
 func (s *DotLookupContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(Excellent2Listener); ok {
 		listenerT.EnterDotLookup(s)
@@ -1700,6 +2281,14 @@ func (s *ContextReferenceContext) NAME() antlr.TerminalNode {
 	return s.GetToken(Excellent2ParserNAME, 0)
 }
 
+func (s *ContextReferenceContext) AND() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserAND, 0)
+}
+
+func (s *ContextReferenceContext) OR() antlr.TerminalNode {
+	return s.GetToken(Excellent2ParserOR, 0)
+}
+
 func (s *ContextReferenceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(Excellent2Listener); ok {
 		listenerT.EnterContextReference(s)
@@ -1777,13 +2366,20 @@ func (p *Excellent2Parser) atom(_p int) (localctx IAtomContext) {
 			p.Match(Excellent2ParserRPAREN)
 		}
 
-	case Excellent2ParserNAME:
+	case Excellent2ParserAND, Excellent2ParserOR, Excellent2ParserNAME:
 		localctx = NewContextReferenceContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
 			p.SetState(50)
-			p.Match(Excellent2ParserNAME)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la-29)&-(0x1f+1)) == 0 && ((1<<uint((_la-29)))&((1<<(Excellent2ParserAND-29))|(1<<(Excellent2ParserOR-29))|(1<<(Excellent2ParserNAME-29)))) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
 
 	default:
@@ -1819,7 +2415,7 @@ func (p *Excellent2Parser) atom(_p int) (localctx IAtomContext) {
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
-				if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<Excellent2ParserLPAREN)|(1<<Excellent2ParserMINUS)|(1<<Excellent2ParserTEXT)|(1<<Excellent2ParserINTEGER)|(1<<Excellent2ParserDECIMAL)|(1<<Excellent2ParserTRUE)|(1<<Excellent2ParserFALSE)|(1<<Excellent2ParserNULL)|(1<<Excellent2ParserNAME))) != 0 {
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<Excellent2ParserLPAREN)|(1<<Excellent2ParserMINUS)|(1<<Excellent2ParserTEXT)|(1<<Excellent2ParserINTEGER)|(1<<Excellent2ParserDECIMAL)|(1<<Excellent2ParserTRUE)|(1<<Excellent2ParserFALSE)|(1<<Excellent2ParserNULL)|(1<<Excellent2ParserAND)|(1<<Excellent2ParserOR)|(1<<Excellent2ParserNOT))) != 0) || _la == Excellent2ParserNAME {
 					{
 						p.SetState(55)
 						p.Parameters()
@@ -1845,10 +2441,17 @@ func (p *Excellent2Parser) atom(_p int) (localctx IAtomContext) {
 				}
 				{
 					p.SetState(61)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*DotLookupContext).property = _lt
+
 					_la = p.GetTokenStream().LA(1)
 
-					if !(_la == Excellent2ParserINTEGER || _la == Excellent2ParserNAME) {
-						p.GetErrorHandler().RecoverInline(p)
+					if !(((_la-24)&-(0x1f+1)) == 0 && ((1<<uint((_la-24)))&((1<<(Excellent2ParserINTEGER-24))|(1<<(Excellent2ParserAND-24))|(1<<(Excellent2ParserOR-24))|(1<<(Excellent2ParserNOT-24))|(1<<(Excellent2ParserNAME-24)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*DotLookupContext).property = _ri
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
 						p.Consume()
@@ -2081,22 +2684,34 @@ func (p *Excellent2Parser) Sempred(localctx antlr.RuleContext, ruleIndex, predIn
 func (p *Excellent2Parser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 11)
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 10)
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 9)
+		return p.Precpred(p.GetParserRuleContext(), 15)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 14)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 13)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 12)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 10)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 9)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 8)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -2105,13 +2720,13 @@ func (p *Excellent2Parser) Expression_Sempred(localctx antlr.RuleContext, predIn
 
 func (p *Excellent2Parser) Atom_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 6:
+	case 10:
 		return p.Precpred(p.GetParserRuleContext(), 5)
 
-	case 7:
+	case 11:
 		return p.Precpred(p.GetParserRuleContext(), 4)
 
-	case 8:
+	case 12:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	default:
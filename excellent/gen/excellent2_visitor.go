@@ -49,6 +49,24 @@ type Excellent2Visitor interface {
 	// Visit a parse tree produced by Excellent2Parser#exponent.
 	VisitExponent(ctx *ExponentContext) interface{}
 
+	// Visit a parse tree produced by Excellent2Parser#anonFunction.
+	VisitAnonFunction(ctx *AnonFunctionContext) interface{}
+
+	// Visit a parse tree produced by Excellent2Parser#not.
+	VisitNot(ctx *NotContext) interface{}
+
+	// Visit a parse tree produced by Excellent2Parser#and.
+	VisitAnd(ctx *AndContext) interface{}
+
+	// Visit a parse tree produced by Excellent2Parser#or.
+	VisitOr(ctx *OrContext) interface{}
+
+	// Visit a parse tree produced by Excellent2Parser#coalesce.
+	VisitCoalesce(ctx *CoalesceContext) interface{}
+
+	// Visit a parse tree produced by Excellent2Parser#conditional.
+	VisitConditional(ctx *ConditionalContext) interface{}
+
 	// Visit a parse tree produced by Excellent2Parser#parentheses.
 	VisitParentheses(ctx *ParenthesesContext) interface{}
 
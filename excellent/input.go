@@ -11,6 +11,8 @@ type xinput struct {
 	base        *bufio.Reader
 	unreadRunes []rune
 	unreadCount int
+	offset      int   // number of runes read so far
+	lineStarts  []int // offsets of the starts of lines after the first
 }
 
 func newInput(base *bufio.Reader) *xinput {
@@ -26,6 +28,7 @@ func (r *xinput) read() rune {
 	if r.unreadCount > 0 {
 		ch := r.unreadRunes[r.unreadCount-1]
 		r.unreadCount--
+		r.advance(ch)
 		return ch
 	}
 
@@ -34,11 +37,42 @@ func (r *xinput) read() rune {
 	if err != nil {
 		return eof
 	}
+	r.advance(ch)
 	return ch
 }
 
+// moves our offset past the given rune
+func (r *xinput) advance(ch rune) {
+	if ch == eof {
+		return
+	}
+
+	r.offset++
+
+	if ch == '\n' && (len(r.lineStarts) == 0 || r.lineStarts[len(r.lineStarts)-1] < r.offset) {
+		r.lineStarts = append(r.lineStarts, r.offset)
+	}
+}
+
+// gets the line (starting at 1) and column (starting at 0) of the given offset
+func (r *xinput) position(offset int) (int, int) {
+	line, lineStart := 1, 0
+	for _, start := range r.lineStarts {
+		if start > offset {
+			break
+		}
+		line++
+		lineStart = start
+	}
+	return line, offset - lineStart
+}
+
 // pops the passed in rune as the next rune to be returned
 func (r *xinput) unread(ch rune) {
 	r.unreadRunes[r.unreadCount] = ch
 	r.unreadCount++
+
+	if ch != eof {
+		r.offset--
+	}
 }
@@ -24,3 +24,29 @@ func TestInput(t *testing.T) {
 	assert.Equal(t, '😊', input.read())
 	assert.Equal(t, eof, input.read())
 }
+
+func TestInputPosition(t *testing.T) {
+	input := newInput(bufio.NewReader(strings.NewReader("a😊\nb\n\nc")))
+
+	for ch := input.read(); ch != eof; ch = input.read() {
+	}
+
+	assert.Equal(t, 7, input.offset)
+
+	input.unread('c')
+	input.unread('\n')
+
+	assert.Equal(t, 5, input.offset)
+	assert.Equal(t, '\n', input.read())
+	assert.Equal(t, 'c', input.read())
+	assert.Equal(t, []int{3, 5, 6}, input.lineStarts)
+
+	line, col := input.position(0)
+	assert.Equal(t, []int{1, 0}, []int{line, col})
+	line, col = input.position(2)
+	assert.Equal(t, []int{1, 2}, []int{line, col})
+	line, col = input.position(4)
+	assert.Equal(t, []int{2, 1}, []int{line, col})
+	line, col = input.position(6)
+	assert.Equal(t, []int{4, 0}, []int{line, col})
+}
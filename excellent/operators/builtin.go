@@ -5,6 +5,7 @@ import (
 
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/utils"
 )
 
 // Concatenate joins two text values together.
@@ -42,32 +43,82 @@ var NotEqual = textualBinary(func(env envs.Environment, text1 types.XText, text2
 	return types.NewXBoolean(!text1.Equals(text2))
 })
 
-// Negate negates a number
+// Negate negates a number or a duration
 //
 //   @(-fields.age) -> -23
+//   @(-duration(2, "D")) -> -P2D
 //
 // @operator negate "- (unary)"
-var Negate = numericalUnary(func(env envs.Environment, num types.XNumber) types.XValue {
+func Negate(env envs.Environment, arg types.XValue) types.XValue {
+	if duration, isDuration := arg.(types.XDuration); isDuration {
+		return duration.Negate()
+	}
+	return negateNumber(env, arg)
+}
+
+var negateNumber = numericalUnary(func(env envs.Environment, num types.XNumber) types.XValue {
 	return types.NewXNumber(num.Native().Neg())
 })
 
-// Add adds two numbers.
+// Add adds two numbers, two durations, or a duration to a date or datetime. Text which is a valid
+// ISO 8601 duration such as `PT2H` is treated as a duration.
 //
 //   @(2 + 3) -> 5
 //   @(fields.age + 10) -> 33
+//   @(datetime("2020-01-15T10:30:00Z") + duration(2, "h")) -> 2020-01-15T12:30:00.000000Z
+//   @(date("2020-01-15") + duration(1, "M")) -> 2020-02-15
+//   @(duration(1, "h") + duration(30, "m")) -> PT1H30M
+//   @(datetime("2020-01-15T10:30:00Z") + "PT2H") -> 2020-01-15T12:30:00.000000Z
 //
 // @operator add "+"
-var Add = numericalBinary(func(env envs.Environment, num1 types.XNumber, num2 types.XNumber) types.XValue {
+func Add(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	duration1, isDuration1 := asDuration(env, arg1)
+	duration2, isDuration2 := asDuration(env, arg2)
+
+	switch {
+	case isDuration1 && isDuration2:
+		return duration1.Add(duration2)
+	case isDuration2:
+		return addDuration(env, arg1, duration2)
+	case isDuration1:
+		return addDuration(env, arg2, duration1)
+	}
+
+	return addNumbers(env, arg1, arg2)
+}
+
+var addNumbers = numericalBinary(func(env envs.Environment, num1 types.XNumber, num2 types.XNumber) types.XValue {
 	return types.NewXNumber(num1.Native().Add(num2.Native()))
 })
 
-// Subtract subtracts two numbers.
+// Subtract subtracts two numbers, two durations, a duration from a date or datetime, or
+// two dates or datetimes to get the duration between them. As with addition, text which is a valid ISO 8601
+// duration is treated as a duration.
 //
 //   @(3 - 2) -> 1
 //   @(2 - 3) -> -1
+//   @(datetime("2020-01-15T10:30:00Z") - duration(1, "D")) -> 2020-01-14T10:30:00.000000Z
+//   @(datetime("2020-01-15T10:30:00Z") - datetime("2020-01-15T08:00:00Z")) -> PT2H30M
+//   @(date("2020-03-01") - date("2020-02-01")) -> P29D
 //
 // @operator subtract "- (binary)"
-var Subtract = numericalBinary(func(env envs.Environment, num1 types.XNumber, num2 types.XNumber) types.XValue {
+func Subtract(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	duration1, isDuration1 := asDuration(env, arg1)
+	duration2, isDuration2 := asDuration(env, arg2)
+
+	switch {
+	case isDuration1 && isDuration2:
+		return duration1.Add(duration2.Negate())
+	case isDuration2:
+		return addDuration(env, arg1, duration2.Negate())
+	case isTemporal(arg1) && isTemporal(arg2):
+		return durationBetween(env, arg1, arg2)
+	}
+
+	return subtractNumbers(env, arg1, arg2)
+}
+
+var subtractNumbers = numericalBinary(func(env envs.Environment, num1 types.XNumber, num2 types.XNumber) types.XValue {
 	return types.NewXNumber(num1.Native().Sub(num2.Native()))
 })
 
@@ -149,3 +200,76 @@ var GreaterThan = numericalBinary(func(env envs.Environment, num1 types.XNumber,
 var GreaterThanOrEqual = numericalBinary(func(env envs.Environment, num1 types.XNumber, num2 types.XNumber) types.XValue {
 	return types.NewXBoolean(num1.Compare(num2) >= 0)
 })
+
+// Not returns the logical negation of a value.
+//
+//   @(not true) -> false
+//   @(not (2 > 3)) -> true
+//   @(not "") -> true
+//
+// @operator not "not"
+var Not = booleanUnary(func(env envs.Environment, b types.XBoolean) types.XValue {
+	return types.NewXBoolean(!b.Native())
+})
+
+// And returns true if both values are truthy. The second value is only evaluated if the first is truthy.
+//
+//   @(true and false) -> false
+//   @(1 < 2 and 2 < 3) -> true
+//   @(false and 1 / 0) -> false
+//
+// @operator and "and"
+var And = booleanLazyBinary(func(env envs.Environment, b1 types.XBoolean, arg2 func() types.XValue) types.XValue {
+	if !b1.Native() {
+		return types.XBooleanFalse
+	}
+	return toBoolean(arg2())
+})
+
+// Or returns true if either value is truthy. The second value is only evaluated if the first is not truthy.
+//
+//   @(true or false) -> true
+//   @(2 < 1 or 3 < 2) -> false
+//   @(true or 1 / 0) -> true
+//
+// @operator or "or"
+var Or = booleanLazyBinary(func(env envs.Environment, b1 types.XBoolean, arg2 func() types.XValue) types.XValue {
+	if b1.Native() {
+		return types.XBooleanTrue
+	}
+	return toBoolean(arg2())
+})
+
+// Coalesce returns the first value unless it is null or an error, in which case the second value is returned.
+//
+//   @(fields.gender ?? "unknown") -> Male
+//   @(null ?? "unknown") -> unknown
+//   @(1 / 0 ?? 0) -> 0
+//
+// @operator coalesce "??"
+func Coalesce(env envs.Environment, arg1 types.XValue, arg2 func() types.XValue) types.XValue {
+	if utils.IsNil(arg1) || types.IsXError(arg1) {
+		return arg2()
+	}
+	return arg1
+}
+
+// Conditional returns the first value if the test is truthy or the second value if not. Only the returned
+// value is evaluated. If the test is an error that error is returned.
+//
+//   @(1 = 1 ? "foo" : "bar") -> foo
+//   @(fields.age >= 18 ? "adult" : "child") -> adult
+//   @("foo" > "bar" ? "foo" : "bar") -> ERROR
+//
+// @operator conditional "? :"
+func Conditional(env envs.Environment, test types.XValue, value1 func() types.XValue, value2 func() types.XValue) types.XValue {
+	asBool, xerr := types.ToXBoolean(test)
+	if xerr != nil {
+		return xerr
+	}
+
+	if asBool.Native() {
+		return value1()
+	}
+	return value2()
+}
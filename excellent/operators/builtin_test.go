@@ -3,11 +3,13 @@ package operators_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/operators"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/dates"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,6 +18,9 @@ var xs = types.NewXText
 var xn = types.RequireXNumberFromString
 var xi = types.NewXNumberFromInt
 var xa = types.NewXArray
+var xd = types.NewXDate
+var xdt = types.NewXDateTime
+var xdu = types.NewXDuration
 var ERROR = types.NewXErrorf("any error")
 
 func TestBinaryOperators(t *testing.T) {
@@ -51,12 +56,33 @@ func TestBinaryOperators(t *testing.T) {
 		{operators.Add, xs("1"), xs("3"), xi(4)},
 		{operators.Add, ERROR, xi(1), ERROR},
 		{operators.Add, xi(1), ERROR, ERROR},
+		{operators.Add, xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xdu(1, 2, time.Hour), xdt(time.Date(2020, 2, 17, 11, 30, 0, 0, time.UTC))},
+		{operators.Add, xdu(0, 0, time.Hour), xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xdt(time.Date(2020, 1, 15, 11, 30, 0, 0, time.UTC))},
+		{operators.Add, xs("2020-01-15T10:30:00Z"), xdu(0, 1, 0), xdt(time.Date(2020, 1, 16, 10, 30, 0, 0, time.UTC))},
+		{operators.Add, xd(dates.NewDate(2020, 1, 15)), xdu(1, 1, 0), xd(dates.NewDate(2020, 2, 16))},
+		{operators.Add, xd(dates.NewDate(2020, 1, 15)), xdu(0, 0, time.Hour), xdt(time.Date(2020, 1, 15, 1, 0, 0, 0, time.UTC))},
+		{operators.Add, xdu(1, 0, time.Hour), xdu(0, 2, time.Minute), xdu(1, 2, time.Hour+time.Minute)},
+		{operators.Add, xi(1), xdu(0, 1, 0), ERROR},
+		{operators.Add, ERROR, xdu(0, 1, 0), ERROR},
+		{operators.Add, xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xs("PT2H"), xdt(time.Date(2020, 1, 15, 12, 30, 0, 0, time.UTC))},
+		{operators.Add, xs("P1D"), xs("PT1H"), xdu(0, 1, time.Hour)},
+		{operators.Add, xs("P1D"), xi(1), ERROR},
 
 		{operators.Subtract, xi(1), xi(3), xi(-2)},
 		{operators.Subtract, xi(3), xi(1), xi(2)},
 		{operators.Subtract, xs("3"), xs("1"), xi(2)},
 		{operators.Subtract, ERROR, xi(1), ERROR},
 		{operators.Subtract, xi(1), ERROR, ERROR},
+		{operators.Subtract, xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xdu(1, 2, time.Hour), xdt(time.Date(2019, 12, 13, 9, 30, 0, 0, time.UTC))},
+		{operators.Subtract, xd(dates.NewDate(2020, 1, 15)), xdu(0, 14, 0), xd(dates.NewDate(2020, 1, 1))},
+		{operators.Subtract, xdu(1, 2, time.Hour), xdu(0, 2, time.Minute), xdu(1, 0, 59*time.Minute)},
+		{operators.Subtract, xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xdt(time.Date(2020, 1, 14, 9, 0, 0, 0, time.UTC)), xdu(0, 0, 25*time.Hour+30*time.Minute)},
+		{operators.Subtract, xd(dates.NewDate(2020, 3, 1)), xd(dates.NewDate(2020, 2, 1)), xdu(0, 29, 0)},
+		{operators.Subtract, xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), xd(dates.NewDate(2020, 1, 15)), xdu(0, 0, 10*time.Hour+30*time.Minute)},
+		{operators.Subtract, xdu(0, 1, 0), xdt(time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)), ERROR},
+		{operators.Subtract, ERROR, xdu(0, 1, 0), ERROR},
+		{operators.Subtract, xd(dates.NewDate(2020, 1, 15)), xs("P2D"), xd(dates.NewDate(2020, 1, 13))},
+		{operators.Subtract, xs("PT1H"), xdu(0, 0, 90*time.Minute), xdu(0, 0, -30*time.Minute)},
 
 		{operators.Multiply, xi(2), xi(3), xi(6)},
 		{operators.Multiply, xn("1.5"), xn("2.3"), xn("3.45")},
@@ -126,6 +152,12 @@ func TestUnaryOperators(t *testing.T) {
 		{operators.Negate, xs("123"), xi(-123)},
 		{operators.Negate, xn("123.45"), xn("-123.45")},
 		{operators.Negate, ERROR, ERROR},
+		{operators.Negate, xdu(1, 2, time.Hour), xdu(-1, -2, -time.Hour)},
+
+		{operators.Not, types.XBooleanTrue, types.XBooleanFalse},
+		{operators.Not, xs(""), types.XBooleanTrue},
+		{operators.Not, nil, types.XBooleanTrue},
+		{operators.Not, ERROR, ERROR},
 	}
 
 	for _, tc := range testCases {
@@ -141,3 +173,69 @@ func TestUnaryOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestLazyBinaryOperators(t *testing.T) {
+	env := envs.NewBuilder().Build()
+
+	testCases := []struct {
+		operator  operators.LazyBinaryOperator
+		arg1      types.XValue
+		arg2      types.XValue
+		expected  types.XValue
+		evaluated bool
+	}{
+		{operators.And, types.XBooleanTrue, types.XBooleanTrue, types.XBooleanTrue, true},
+		{operators.And, types.XBooleanTrue, xs(""), types.XBooleanFalse, true},
+		{operators.And, types.XBooleanFalse, types.XBooleanTrue, types.XBooleanFalse, false},
+		{operators.And, types.XBooleanTrue, ERROR, ERROR, true},
+		{operators.And, ERROR, types.XBooleanTrue, ERROR, false},
+
+		{operators.Or, types.XBooleanFalse, xi(1), types.XBooleanTrue, true},
+		{operators.Or, nil, types.XBooleanFalse, types.XBooleanFalse, true},
+		{operators.Or, types.XBooleanTrue, types.XBooleanFalse, types.XBooleanTrue, false},
+		{operators.Or, types.XBooleanFalse, ERROR, ERROR, true},
+		{operators.Or, ERROR, types.XBooleanTrue, ERROR, false},
+
+		{operators.Coalesce, xs("hello"), xs("world"), xs("hello"), false},
+		{operators.Coalesce, xs(""), xs("world"), xs(""), false},
+		{operators.Coalesce, nil, xs("world"), xs("world"), true},
+		{operators.Coalesce, ERROR, xs("world"), xs("world"), true},
+		{operators.Coalesce, nil, ERROR, ERROR, true},
+	}
+
+	for _, tc := range testCases {
+		testID := fmt.Sprintf("%v(%s, %s)", tc.operator, tc.arg1, tc.arg2)
+
+		evaluated := false
+		result := tc.operator(env, tc.arg1, func() types.XValue {
+			evaluated = true
+			return tc.arg2
+		})
+
+		assert.Equal(t, tc.evaluated, evaluated, "evaluation mismatch for %s", testID)
+
+		// don't check error equality - just check that we got an error if we expected one
+		if tc.expected == ERROR {
+			assert.True(t, types.IsXError(result), "expecting error, got %T{%s} for ", result, result, testID)
+		} else {
+			test.AssertXEqual(t, tc.expected, result, "result mismatch for %s", testID)
+		}
+	}
+}
+
+func TestConditional(t *testing.T) {
+	env := envs.NewBuilder().Build()
+
+	evaluated := make([]string, 0)
+	value := func(v types.XValue) func() types.XValue {
+		return func() types.XValue {
+			evaluated = append(evaluated, v.(types.XText).Native())
+			return v
+		}
+	}
+
+	assert.Equal(t, xs("a"), operators.Conditional(env, types.XBooleanTrue, value(xs("a")), value(xs("b"))))
+	assert.Equal(t, xs("b"), operators.Conditional(env, xs(""), value(xs("a")), value(xs("b"))))
+	assert.True(t, types.IsXError(operators.Conditional(env, ERROR, value(xs("a")), value(xs("b")))))
+	assert.Equal(t, []string{"a", "b"}, evaluated)
+}
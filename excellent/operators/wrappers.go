@@ -1,8 +1,11 @@
 package operators
 
 import (
+	"time"
+
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/utils/dates"
 )
 
 // UnaryOperator is an operator which takes a single argument
@@ -11,6 +14,9 @@ type UnaryOperator func(envs.Environment, types.XValue) types.XValue
 // BinaryOperator is an operator which takes two arguments
 type BinaryOperator func(envs.Environment, types.XValue, types.XValue) types.XValue
 
+// LazyBinaryOperator is an operator which takes two arguments, the second of which is only evaluated if needed
+type LazyBinaryOperator func(envs.Environment, types.XValue, func() types.XValue) types.XValue
+
 func textualBinary(f func(envs.Environment, types.XText, types.XText) types.XValue) BinaryOperator {
 	return func(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
 		text1, xerr := types.ToXText(env, arg1)
@@ -51,3 +57,85 @@ func numericalBinary(f func(envs.Environment, types.XNumber, types.XNumber) type
 		return f(env, num1, num2)
 	}
 }
+
+func booleanUnary(f func(envs.Environment, types.XBoolean) types.XValue) UnaryOperator {
+	return func(env envs.Environment, arg types.XValue) types.XValue {
+		b, xerr := types.ToXBoolean(arg)
+		if xerr != nil {
+			return xerr
+		}
+
+		return f(env, b)
+	}
+}
+
+func booleanLazyBinary(f func(envs.Environment, types.XBoolean, func() types.XValue) types.XValue) LazyBinaryOperator {
+	return func(env envs.Environment, arg1 types.XValue, arg2 func() types.XValue) types.XValue {
+		b1, xerr := types.ToXBoolean(arg1)
+		if xerr != nil {
+			return xerr
+		}
+
+		return f(env, b1, arg2)
+	}
+}
+
+func toBoolean(arg types.XValue) types.XValue {
+	b, xerr := types.ToXBoolean(arg)
+	if xerr != nil {
+		return xerr
+	}
+	return b
+}
+
+// gets a value as a duration if it is one or is text which can be parsed as one
+func asDuration(env envs.Environment, arg types.XValue) (types.XDuration, bool) {
+	duration, xerr := types.ToXDuration(env, arg)
+	return duration, xerr == nil
+}
+
+// adds a duration to a value which should be a date or datetime. Dates remain dates unless the duration has a time part.
+func addDuration(env envs.Environment, arg types.XValue, duration types.XDuration) types.XValue {
+	if date, isDate := arg.(types.XDate); isDate && duration.Time() == 0 {
+		result, xerr := duration.AddToDate(date)
+		if xerr != nil {
+			return xerr
+		}
+		return result
+	}
+
+	datetime, xerr := types.ToXDateTime(env, arg)
+	if xerr != nil {
+		return xerr
+	}
+
+	return duration.AddToDateTime(datetime)
+}
+
+// gets the duration between two dates or datetimes. The duration between two dates is a number of days.
+func durationBetween(env envs.Environment, arg1 types.XValue, arg2 types.XValue) types.XValue {
+	date1, isDate1 := arg1.(types.XDate)
+	date2, isDate2 := arg2.(types.XDate)
+	if isDate1 && isDate2 {
+		return types.NewXDuration(0, dates.DaysBetween(date1.Native().Combine(dates.ZeroTimeOfDay, time.UTC), date2.Native().Combine(dates.ZeroTimeOfDay, time.UTC)), 0)
+	}
+
+	datetime1, xerr := types.ToXDateTime(env, arg1)
+	if xerr != nil {
+		return xerr
+	}
+	datetime2, xerr := types.ToXDateTime(env, arg2)
+	if xerr != nil {
+		return xerr
+	}
+
+	return types.DurationBetween(datetime1, datetime2)
+}
+
+func isTemporal(arg types.XValue) bool {
+	switch arg.(type) {
+	case types.XDate, types.XDateTime:
+		return true
+	}
+	return false
+}
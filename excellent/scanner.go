@@ -7,6 +7,8 @@ import (
 	"io"
 	"strings"
 	"unicode"
+
+	"github.com/nyaruka/goflow/utils"
 )
 
 // XTokenType is a set of types than can be scanned
@@ -36,15 +38,33 @@ type Scanner interface {
 	SetUnescapeBody(bool)
 }
 
+// PositionScanner is a scanner which also tracks where tokens start and the syntax errors it encounters
+type PositionScanner interface {
+	Scanner
+
+	// Offset returns the offset in characters of the start of the last scanned token
+	Offset() int
+
+	// Errors returns the syntax errors encountered so far
+	Errors() []*SyntaxError
+}
+
 // xscanner represents a lexical scanner.
 type xscanner struct {
 	input               *xinput
 	identifierTopLevels []string
 	unescapeBody        bool // unescape @@ sequences in the body
+	start               int  // offset of the start of the current token
+	errors              []*SyntaxError
 }
 
 // NewXScanner returns a new instance of our excellent scanner
 func NewXScanner(r io.Reader, identifierTopLevels []string) Scanner {
+	return NewXPositionScanner(r, identifierTopLevels)
+}
+
+// NewXPositionScanner returns a new instance of our excellent scanner which also tracks positions
+func NewXPositionScanner(r io.Reader, identifierTopLevels []string) PositionScanner {
 	return &xscanner{
 		input:               newInput(bufio.NewReader(r)),
 		identifierTopLevels: identifierTopLevels,
@@ -56,6 +76,29 @@ func (s *xscanner) SetUnescapeBody(unescape bool) {
 	s.unescapeBody = unescape
 }
 
+func (s *xscanner) Offset() int {
+	return s.start
+}
+
+func (s *xscanner) Errors() []*SyntaxError {
+	return s.errors
+}
+
+// records a syntax error at the given offset
+func (s *xscanner) addError(offset int, token, message, context string) {
+	line, column := s.input.position(offset)
+	context = string([]rune(context)[:utils.MinInt(10, len([]rune(context)))])
+
+	s.errors = append(s.errors, &SyntaxError{
+		Line:    line,
+		Column:  column,
+		Offset:  offset,
+		Token:   token,
+		Message: message,
+		context: context,
+	})
+}
+
 // scanExpression consumes the current rune and all contiguous pieces until the end of the expression
 // our read should be after the '('
 func (s *xscanner) scanExpression() (XTokenType, string) {
@@ -65,11 +108,18 @@ func (s *xscanner) scanExpression() (XTokenType, string) {
 	// our parentheses depth
 	parens := 1
 
+	// where the last unclosed text literal started, if any
+	unclosedLiteral := -1
+	unclosedLiteralBuf := 0
+
 	// read every subsequent character until we reach the end of the expression
 	for ch := s.input.read(); ch != eof; ch = s.input.read() {
 		if ch == '"' {
+			offset, bufOffset := s.input.offset-1, buf.Len()
 			buf.WriteRune(ch)
-			s.readTextLiteral(buf)
+			if !s.readTextLiteral(buf) {
+				unclosedLiteral, unclosedLiteralBuf = offset, bufOffset
+			}
 		} else if ch == '(' {
 			buf.WriteRune(ch)
 			parens++
@@ -90,23 +140,33 @@ func (s *xscanner) scanExpression() (XTokenType, string) {
 		return EXPRESSION, buf.String()
 	}
 
-	return BODY, strings.Join([]string{"@(", buf.String()}, "")
+	// this looked like an expression but was never closed, so treat it as body but record the problem
+	body := strings.Join([]string{"@(", buf.String()}, "")
+
+	if unclosedLiteral >= 0 {
+		s.addError(unclosedLiteral, `"`, "unclosed text literal", buf.String()[unclosedLiteralBuf:])
+	} else {
+		s.addError(s.start, "@(", "unclosed expression", body)
+	}
+
+	return BODY, body
 }
 
-// reads the remainder of a " quoted text literal
-func (s *xscanner) readTextLiteral(buf *bytes.Buffer) {
+// reads the remainder of a " quoted text literal, returning whether it was closed
+func (s *xscanner) readTextLiteral(buf *bytes.Buffer) bool {
 	escaped := false
 	for ch := s.input.read(); ch != eof; ch = s.input.read() {
 		buf.WriteRune(ch)
 
 		if ch == '"' && !escaped {
-			break
+			return true
 		} else if ch == '\\' {
 			escaped = true
 		} else {
 			escaped = false
 		}
 	}
+	return false
 }
 
 // scanIdentifier consumes the current rune and all contiguous pieces until the end of the identifer
@@ -214,6 +274,8 @@ func (s *xscanner) scanBody() (XTokenType, string) {
 
 // Scan returns the next token and literal value.
 func (s *xscanner) Scan() (XTokenType, string) {
+	s.start = s.input.offset
+
 	for ch := s.input.read(); ch != eof; ch = s.input.read() {
 		switch ch {
 		case '@':
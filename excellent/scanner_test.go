@@ -42,3 +42,36 @@ func TestScanner(t *testing.T) {
 		assert.Equal(t, test.tokens, tokens, "scan failed for input %s", test.input)
 	}
 }
+
+func TestScannerPositions(t *testing.T) {
+	scanner := excellent.NewXPositionScanner(strings.NewReader("Hi @contact.name ✓\n@(upper(\"a\")) @@ @("), []string{"contact"})
+
+	offsets := make([]int, 0)
+	for tokenType, _ := scanner.Scan(); tokenType != excellent.EOF; tokenType, _ = scanner.Scan() {
+		offsets = append(offsets, scanner.Offset())
+	}
+
+	assert.Equal(t, []int{0, 3, 16, 19, 32, 36}, offsets)
+
+	errs := scanner.Errors()
+	if assert.Equal(t, 1, len(errs)) {
+		assert.Equal(t, 2, errs[0].Line)
+		assert.Equal(t, 17, errs[0].Column)
+		assert.Equal(t, 36, errs[0].Offset)
+		assert.Equal(t, "@(", errs[0].Token)
+		assert.Equal(t, "unclosed expression", errs[0].Message)
+		assert.Equal(t, "syntax error at @(", errs[0].Error())
+	}
+
+	scanner = excellent.NewXPositionScanner(strings.NewReader(`@(upper("abc)`), nil)
+	for tokenType, _ := scanner.Scan(); tokenType != excellent.EOF; tokenType, _ = scanner.Scan() {
+	}
+
+	errs = scanner.Errors()
+	if assert.Equal(t, 1, len(errs)) {
+		assert.Equal(t, 8, errs[0].Offset)
+		assert.Equal(t, `"`, errs[0].Token)
+		assert.Equal(t, "unclosed text literal", errs[0].Message)
+		assert.Equal(t, `syntax error at "abc)`, errs[0].Error())
+	}
+}
@@ -0,0 +1,149 @@
+package excellent
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Template is a template which has been scanned and had its expressions parsed, so that it can be
+// evaluated many times against different contexts without being parsed again
+type Template struct {
+	parts []*templatePart
+
+	// the compiled form of the trimmed template if that differs, used when evaluating as a value
+	trimmed *Template
+}
+
+// a single body, identifier or expression token of a template
+type templatePart struct {
+	tokenType XTokenType
+	token     string
+	tree      antlr.ParseTree
+	err       error
+}
+
+// CompileTemplate scans the given template and parses all of its expressions
+func CompileTemplate(template string, allowedTopLevels []string) *Template {
+	t := compileParts(template, template, 0, allowedTopLevels)
+
+	if trimmed := strings.TrimSpace(template); trimmed != template {
+		leading := len([]rune(strings.TrimRightFunc(template, unicode.IsSpace))) - len([]rune(trimmed))
+
+		t.trimmed = compileParts(trimmed, template, leading, allowedTopLevels)
+	}
+	return t
+}
+
+// compiles the given text which starts at the given offset in the given template
+func compileParts(text, template string, offset int, allowedTopLevels []string) *Template {
+	t := &Template{}
+
+	// nothing todo for an empty template
+	if text == "" {
+		return t
+	}
+
+	scanner := NewXPositionScanner(strings.NewReader(text), allowedTopLevels)
+
+	for tokenType, token := scanner.Scan(); tokenType != EOF; tokenType, token = scanner.Scan() {
+		part := &templatePart{tokenType: tokenType, token: token}
+
+		if tokenType == IDENTIFIER || tokenType == EXPRESSION {
+			part.tree, part.err = parseExpression(token)
+
+			// syntax errors are positioned relative to the start of the template
+			if syntaxErr, isSyntax := part.err.(*SyntaxError); isSyntax {
+				start := offset + scanner.Offset() + 1
+				if tokenType == EXPRESSION {
+					start++
+				}
+				syntaxErr.Shift(template, start)
+			}
+		}
+
+		t.parts = append(t.parts, part)
+	}
+	return t
+}
+
+// Evaluate evaluates this template as text in the given context
+func (t *Template) Evaluate(env envs.Environment, context *types.XObject, escaping Escaping) (string, error) {
+	var buf strings.Builder
+	errors := NewTemplateErrors()
+
+	for _, part := range t.parts {
+		switch part.tokenType {
+		case BODY:
+			buf.WriteString(part.token)
+		case IDENTIFIER, EXPRESSION:
+			// if the expression couldn't be parsed, record that with its position
+			if part.err != nil {
+				errors.AddError(part.repr(), part.err)
+				continue
+			}
+
+			value := part.evaluate(env, context)
+
+			// if we got an error, record that
+			if types.IsXError(value) {
+				errors.Add(part.repr(), value.(error).Error())
+				continue
+			}
+
+			// if not, stringify value and append to the output
+			asText, _ := types.ToXText(env, value)
+			asString := asText.Native()
+
+			if escaping != nil {
+				asString = escaping(asString)
+			}
+
+			buf.WriteString(asString)
+		}
+	}
+
+	if errors.HasErrors() {
+		return buf.String(), errors
+	}
+	return buf.String(), nil
+}
+
+// EvaluateValue is equivalent to Evaluate except in the case where the trimmed template contains
+// a single identifier or expression, in which case the typed value of that is returned
+func (t *Template) EvaluateValue(env envs.Environment, context *types.XObject) (types.XValue, error) {
+	if t.trimmed != nil {
+		return t.trimmed.EvaluateValue(env, context)
+	}
+
+	// if we only have an identifier or an expression, evaluate it on its own
+	if len(t.parts) == 1 && t.parts[0].tokenType != BODY {
+		return t.parts[0].evaluate(env, context), nil
+	}
+
+	// otherwise fallback to full template evaluation
+	asStr, err := t.Evaluate(env, context, nil)
+	return types.NewXText(asStr), err
+}
+
+// evaluates this part, which must be an identifier or expression
+func (p *templatePart) evaluate(env envs.Environment, context *types.XObject) types.XValue {
+	if p.err != nil {
+		return types.NewXError(p.err)
+	}
+
+	visitor := newEvaluationVisitor(env, context)
+	return toXValue(visitor.Visit(p.tree))
+}
+
+// gets the representation of this part as it appeared in the template
+func (p *templatePart) repr() string {
+	if p.tokenType == IDENTIFIER {
+		return "@" + p.token
+	}
+	return "@(" + p.token + ")"
+}
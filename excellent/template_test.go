@@ -0,0 +1,108 @@
+package excellent_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent"
+	"github.com/nyaruka/goflow/excellent/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileTemplate(t *testing.T) {
+	env := envs.NewBuilder().Build()
+	ctx1 := types.NewXObject(map[string]types.XValue{"name": types.NewXText("Bob"), "age": types.NewXNumberFromInt(23)})
+	ctx2 := types.NewXObject(map[string]types.XValue{"name": types.NewXText("Jim"), "age": types.NewXNumberFromInt(45)})
+
+	// a compiled template can be evaluated against different contexts
+	template := excellent.CompileTemplate("Hi @name, next year you'll be @(age + 1)", []string{"name", "age"})
+
+	value, err := template.Evaluate(env, ctx1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi Bob, next year you'll be 24", value)
+
+	value, err = template.Evaluate(env, ctx2, func(s string) string { return "<" + s + ">" })
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi <Jim>, next year you'll be <46>", value)
+
+	// errors are reported for each expression but evaluation continues
+	template = excellent.CompileTemplate("@(1 / 0) @name @(age +) @foo", []string{"name", "age", "foo"})
+
+	value, err = template.Evaluate(env, ctx1, nil)
+	assert.EqualError(t, err, "error evaluating @(1 / 0): division by zero, error evaluating @(age +): syntax error at , error evaluating @foo: context has no property 'foo'")
+	assert.Equal(t, " Bob  ", value)
+
+	// syntax errors are positioned relative to the start of the template
+	errs := err.(*excellent.TemplateErrors).Errors()
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, "@(age +)", errs[1].Expression())
+	assert.Nil(t, errs[0].SyntaxError())
+	assert.Nil(t, errs[2].SyntaxError())
+	assert.Equal(t, 1, errs[1].SyntaxError().Line)
+	assert.Equal(t, 22, errs[1].SyntaxError().Offset)
+
+	template = excellent.CompileTemplate(" \n @(age +) ", []string{"name", "age"})
+
+	_, err = template.Evaluate(env, ctx1, nil)
+	assert.EqualError(t, err, "error evaluating @(age +): syntax error at ")
+	syntaxErr := err.(*excellent.TemplateErrors).Errors()[0].SyntaxError()
+	assert.Equal(t, 2, syntaxErr.Line)
+	assert.Equal(t, 8, syntaxErr.Column)
+	assert.Equal(t, 10, syntaxErr.Offset)
+
+	// a single expression can be evaluated as a typed value
+	template = excellent.CompileTemplate("  @(age * 2) ", []string{"name", "age"})
+
+	xvalue, err := template.EvaluateValue(env, ctx1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewXNumberFromInt(46), xvalue)
+
+	value, err = template.Evaluate(env, ctx1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "  46 ", value)
+
+	// but anything else is evaluated as text
+	template = excellent.CompileTemplate("@age years", []string{"name", "age"})
+
+	xvalue, err = template.EvaluateValue(env, ctx1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewXText("23 years"), xvalue)
+
+	xvalue, err = excellent.CompileTemplate("", nil).EvaluateValue(env, ctx1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.XTextEmpty, xvalue)
+}
+
+func TestTemplateCache(t *testing.T) {
+	env := envs.NewBuilder().Build()
+	ctx := types.NewXObject(map[string]types.XValue{"name": types.NewXText("Bob")})
+	topLevels := []string{"name"}
+
+	cache := excellent.NewTemplateCache(2)
+	assert.Equal(t, 0, cache.Len())
+
+	t1 := cache.Compile("Hi @name", topLevels)
+	t2 := cache.Compile("Bye @name", topLevels)
+	assert.Equal(t, 2, cache.Len())
+
+	// fetching a cached template returns the same compiled template
+	assert.Same(t, t1, cache.Compile("Hi @name", topLevels))
+
+	// the same template with different top levels is cached separately
+	t3 := cache.Compile("Hi @name", []string{"foo"})
+	assert.NotSame(t, t1, t3)
+	assert.Equal(t, 2, cache.Len())
+
+	// least recently used template has been evicted
+	assert.NotSame(t, t2, cache.Compile("Bye @name", topLevels))
+	assert.Same(t, t3, cache.Compile("Hi @name", []string{"foo"}))
+
+	value, err := t3.Evaluate(env, ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi @name", value)
+
+	value, err = t1.Evaluate(env, ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi Bob", value)
+}
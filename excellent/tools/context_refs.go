@@ -2,6 +2,7 @@ package tools
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/nyaruka/goflow/excellent"
 	"github.com/nyaruka/goflow/excellent/functions"
@@ -35,6 +36,7 @@ type auditContextVisitor struct {
 	gen.BaseExcellent2Visitor
 
 	callback func([]string)
+	locals   map[string]bool
 }
 
 // Visit the top level parse tree
@@ -56,7 +58,12 @@ func (v *auditContextVisitor) VisitParse(ctx *gen.ParseContext) interface{} {
 
 // VisitContextReference deals with root variables in the context
 func (v *auditContextVisitor) VisitContextReference(ctx *gen.ContextReferenceContext) interface{} {
-	name := ctx.NAME().GetText()
+	name := ctx.GetText()
+
+	// arguments of anonymous functions aren't context references
+	if v.locals[strings.ToLower(name)] {
+		return nil
+	}
 
 	function := functions.Lookup(name)
 	if function == nil {
@@ -72,13 +79,7 @@ func (v *auditContextVisitor) VisitContextReference(ctx *gen.ContextReferenceCon
 func (v *auditContextVisitor) VisitDotLookup(ctx *gen.DotLookupContext) interface{} {
 	path, isPath := v.Visit(ctx.Atom()).([]string)
 
-	var lookup string
-
-	if ctx.NAME() != nil {
-		lookup = ctx.NAME().GetText()
-	} else {
-		lookup = ctx.INTEGER().GetText()
-	}
+	lookup := ctx.GetProperty().GetText()
 
 	if isPath {
 		path = append(path, lookup)
@@ -161,3 +162,45 @@ func (v *auditContextVisitor) VisitEquality(ctx *gen.EqualityContext) interface{
 func (v *auditContextVisitor) VisitComparison(ctx *gen.ComparisonContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+// VisitNot deals with boolean negations such as not x
+func (v *auditContextVisitor) VisitNot(ctx *gen.NotContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitAnd deals with boolean conjunctions such as x and y
+func (v *auditContextVisitor) VisitAnd(ctx *gen.AndContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitOr deals with boolean disjunctions such as x or y
+func (v *auditContextVisitor) VisitOr(ctx *gen.OrContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitCoalesce deals with null coalescing such as x ?? y
+func (v *auditContextVisitor) VisitCoalesce(ctx *gen.CoalesceContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitConditional deals with conditionals such as x ? y : z
+func (v *auditContextVisitor) VisitConditional(ctx *gen.ConditionalContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitAnonFunction deals with anonymous functions like (x) => x * 2
+func (v *auditContextVisitor) VisitAnonFunction(ctx *gen.AnonFunctionContext) interface{} {
+	outer := v.locals
+	v.locals = make(map[string]bool, len(outer)+len(ctx.AllNAME()))
+	for name := range outer {
+		v.locals[name] = true
+	}
+	for _, name := range ctx.AllNAME() {
+		v.locals[strings.ToLower(name.GetText())] = true
+	}
+
+	v.Visit(ctx.Expression())
+
+	v.locals = outer
+	return nil
+}
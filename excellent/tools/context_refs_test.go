@@ -23,6 +23,9 @@ func TestFindContextRefsInTemplate(t *testing.T) {
 		{`@(foo["bar"])`, [][]string{{`foo`}, {`foo`, `bar`}}, false},
 		{`@(3 * (foo.bar + 1) / 2)`, [][]string{{`foo`}, {`foo`, `bar`}}, false},
 		{`@("foo.bar")`, [][]string{}, false},
+		{`@(filter(foo.items, (x) => x.price > bar))`, [][]string{{`foo`}, {`foo`, `items`}, {`bar`}}, false},
+		{`@(foo.age > 18 ? bar : baz ?? "none")`, [][]string{{`foo`}, {`foo`, `age`}, {`bar`}, {`baz`}}, false},
+		{`@(not foo and or(bar, true))`, [][]string{{`foo`}, {`bar`}}, false},
 		{`@(webhook.0.kd_prov)`, [][]string{[]string{"webhook"}, []string{"webhook", "0"}, []string{"webhook", "0", "kd_prov"}}, false},
 	}
 
@@ -81,12 +81,12 @@ func (v *refactorVisitor) VisitNumberLiteral(ctx *gen.NumberLiteralContext) inte
 
 // VisitDotLookup deals with lookups like foo.0 or foo.bar
 func (v *refactorVisitor) VisitContextReference(ctx *gen.ContextReferenceContext) interface{} {
-	return strings.ToLower(ctx.NAME().GetText())
+	return strings.ToLower(ctx.GetText())
 }
 
 // VisitDotLookup deals with lookups like foo.bar
 func (v *refactorVisitor) VisitDotLookup(ctx *gen.DotLookupContext) interface{} {
-	property := ctx.NAME().GetText()
+	property := ctx.GetProperty().GetText()
 
 	return fmt.Sprintf("%s.%s", v.Visit(ctx.Atom()), strings.ToLower(property))
 }
@@ -179,3 +179,38 @@ func (v *refactorVisitor) VisitEquality(ctx *gen.EqualityContext) interface{} {
 func (v *refactorVisitor) VisitComparison(ctx *gen.ComparisonContext) interface{} {
 	return fmt.Sprintf("%s %s %s", v.Visit(ctx.Expression(0)), ctx.GetOp().GetText(), v.Visit(ctx.Expression(1)))
 }
+
+// VisitNot deals with boolean negations such as not x
+func (v *refactorVisitor) VisitNot(ctx *gen.NotContext) interface{} {
+	return fmt.Sprintf("not %s", v.Visit(ctx.Expression()))
+}
+
+// VisitAnd deals with boolean conjunctions such as x and y
+func (v *refactorVisitor) VisitAnd(ctx *gen.AndContext) interface{} {
+	return fmt.Sprintf("%s and %s", v.Visit(ctx.Expression(0)), v.Visit(ctx.Expression(1)))
+}
+
+// VisitOr deals with boolean disjunctions such as x or y
+func (v *refactorVisitor) VisitOr(ctx *gen.OrContext) interface{} {
+	return fmt.Sprintf("%s or %s", v.Visit(ctx.Expression(0)), v.Visit(ctx.Expression(1)))
+}
+
+// VisitCoalesce deals with null coalescing such as x ?? y
+func (v *refactorVisitor) VisitCoalesce(ctx *gen.CoalesceContext) interface{} {
+	return fmt.Sprintf("%s ?? %s", v.Visit(ctx.Expression(0)), v.Visit(ctx.Expression(1)))
+}
+
+// VisitConditional deals with conditionals such as x ? y : z
+func (v *refactorVisitor) VisitConditional(ctx *gen.ConditionalContext) interface{} {
+	return fmt.Sprintf("%s ? %s : %s", v.Visit(ctx.Expression(0)), v.Visit(ctx.Expression(1)), v.Visit(ctx.Expression(2)))
+}
+
+// VisitAnonFunction deals with anonymous functions like (x) => x * 2
+func (v *refactorVisitor) VisitAnonFunction(ctx *gen.AnonFunctionContext) interface{} {
+	args := make([]string, len(ctx.AllNAME()))
+	for i, name := range ctx.AllNAME() {
+		args[i] = strings.ToLower(name.GetText())
+	}
+
+	return fmt.Sprintf("(%s) => %s", strings.Join(args, ", "), v.Visit(ctx.Expression()))
+}
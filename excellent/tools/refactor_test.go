@@ -30,6 +30,13 @@ func TestRefactorTemplate(t *testing.T) {
 		{`@(AND("x"="y", "x"!="y"))`, `@(and("x" = "y", "x" != "y"))`, false},
 		{`@(AND(1>2, 3<4, 5>=6, 7<=8))`, `@(and(1 > 2, 3 < 4, 5 >= 6, 7 <= 8))`, false},
 		{`@(FOO_Func(x, y))`, `@(foo_func(x, y))`, false},
+		{`@(FILTER(foo,(X)=>X.Price>10))`, `@(filter(foo, (x) => x.price > 10))`, false},
+		{`@((  )=>1)`, `@(() => 1)`, false},
+		{`@(NOT  Foo AND bar OR Baz)`, `@(not foo and bar or baz)`, false},
+		{`@(foo??"none")`, `@(foo ?? "none")`, false},
+		{`@(foo.NOT.Or.and)`, `@(foo.not.or.and)`, false},
+		{`@(Foo>1?"yes":"no")`, `@(foo > 1 ? "yes" : "no")`, false},
+		{`@(AND(foo, OR(bar, baz)))`, `@(and(foo, or(bar, baz)))`, false},
 		{`@(1 / ) @(1+2)`, `@(1 / ) @(1 + 2)`, true},
 	}
 
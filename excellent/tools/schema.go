@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+// names of the primitive types in a schema which don't have properties
+const (
+	SchemaTypeAny      = "any"
+	SchemaTypeText     = "text"
+	SchemaTypeNumber   = "number"
+	SchemaTypeDatetime = "datetime"
+)
+
+// Schema describes the shape of the context and the signatures of functions which templates are checked against.
+// It is read from the completion and function listings generated by docgen.
+type Schema struct {
+	types     map[string]*SchemaType
+	root      map[string]*SchemaProperty
+	functions map[string]*FunctionSignature
+}
+
+// SchemaType is a type of object in the context. A dynamic type allows any key, with every property being of
+// the same type.
+type SchemaType struct {
+	Name         string
+	Dynamic      bool
+	Properties   map[string]*SchemaProperty
+	PropertyType *SchemaProperty
+}
+
+// SchemaProperty is a property of an object in the context
+type SchemaProperty struct {
+	Type  string
+	Array bool
+}
+
+// FunctionParam is a parameter in a function signature
+type FunctionParam struct {
+	Name     string
+	Optional bool
+	Variadic bool
+}
+
+// FunctionSignature is the signature of a function
+type FunctionSignature struct {
+	Name   string
+	Params []*FunctionParam
+}
+
+// MinArgs returns the minimum number of arguments this function accepts, where a variadic parameter which isn't
+// optional requires at least one argument
+func (f *FunctionSignature) MinArgs() int {
+	min := 0
+	for _, p := range f.Params {
+		if !p.Optional {
+			min++
+		}
+	}
+	return min
+}
+
+// MaxArgs returns the maximum number of arguments this function accepts or -1 if it's variadic
+func (f *FunctionSignature) MaxArgs() int {
+	for _, p := range f.Params {
+		if p.Variadic {
+			return -1
+		}
+	}
+	return len(f.Params)
+}
+
+// Param returns the parameter which the argument at the given index is passed to
+func (f *FunctionSignature) Param(index int) *FunctionParam {
+	if index < len(f.Params) {
+		return f.Params[index]
+	}
+	if len(f.Params) > 0 && f.Params[len(f.Params)-1].Variadic {
+		return f.Params[len(f.Params)-1]
+	}
+	return nil
+}
+
+// Type returns the type with the given name or nil if it isn't an object type
+func (s *Schema) Type(name string) *SchemaType {
+	return s.types[name]
+}
+
+// Root returns the top-level property with the given name or nil if it doesn't exist
+func (s *Schema) Root(name string) *SchemaProperty {
+	return s.root[strings.ToLower(name)]
+}
+
+// Function returns the signature of the function with the given name or nil if it isn't known
+func (s *Schema) Function(name string) *FunctionSignature {
+	return s.functions[strings.ToLower(name)]
+}
+
+// ReadSchema reads a schema from the JSON completion and function listings generated by docgen
+func ReadSchema(completionJSON, functionsJSON []byte) (*Schema, error) {
+	completion := &struct {
+		Types []struct {
+			Name       string `json:"name"`
+			Properties []struct {
+				Key   string `json:"key"`
+				Type  string `json:"type"`
+				Array bool   `json:"array"`
+			} `json:"properties"`
+			PropertyTemplate *struct {
+				Type  string `json:"type"`
+				Array bool   `json:"array"`
+			} `json:"property_template"`
+		} `json:"types"`
+		Root []struct {
+			Key   string `json:"key"`
+			Type  string `json:"type"`
+			Array bool   `json:"array"`
+		} `json:"root"`
+	}{}
+	if err := jsonx.Unmarshal(completionJSON, completion); err != nil {
+		return nil, errors.Wrap(err, "unable to read completion")
+	}
+
+	listings := make([]struct {
+		Signature string `json:"signature"`
+	}, 0)
+	if err := jsonx.Unmarshal(functionsJSON, &listings); err != nil {
+		return nil, errors.Wrap(err, "unable to read functions")
+	}
+
+	s := &Schema{
+		types:     make(map[string]*SchemaType, len(completion.Types)),
+		root:      make(map[string]*SchemaProperty, len(completion.Root)),
+		functions: make(map[string]*FunctionSignature, len(listings)),
+	}
+
+	for _, t := range completion.Types {
+		st := &SchemaType{Name: t.Name}
+
+		if t.PropertyTemplate != nil {
+			st.Dynamic = true
+			st.PropertyType = &SchemaProperty{Type: t.PropertyTemplate.Type, Array: t.PropertyTemplate.Array}
+		} else {
+			st.Properties = make(map[string]*SchemaProperty, len(t.Properties))
+			for _, p := range t.Properties {
+				st.Properties[strings.ToLower(p.Key)] = &SchemaProperty{Type: p.Type, Array: p.Array}
+			}
+		}
+		s.types[t.Name] = st
+	}
+
+	for _, p := range completion.Root {
+		s.root[strings.ToLower(p.Key)] = &SchemaProperty{Type: p.Type, Array: p.Array}
+	}
+
+	for _, l := range listings {
+		sig, err := ParseFunctionSignature(l.Signature)
+		if err != nil {
+			return nil, err
+		}
+		s.functions[sig.Name] = sig
+	}
+
+	return s, nil
+}
+
+var signatureRegex = regexp.MustCompile(`^(\w+)\((.*)\)$`)
+var signatureTokenRegex = regexp.MustCompile(`\[|\]|\w+(?:\.\.\.)?`)
+
+// ParseFunctionSignature parses a signature like replace(text, needle, replacement [, count]) where parameters
+// inside brackets are optional and parameters ending with ... can be repeated
+func ParseFunctionSignature(signature string) (*FunctionSignature, error) {
+	match := signatureRegex.FindStringSubmatch(strings.TrimSpace(signature))
+	if match == nil {
+		return nil, errors.Errorf("invalid function signature: %s", signature)
+	}
+
+	sig := &FunctionSignature{Name: strings.ToLower(match[1]), Params: []*FunctionParam{}}
+	depth := 0
+
+	for _, token := range signatureTokenRegex.FindAllString(match[2], -1) {
+		switch token {
+		case "[":
+			depth++
+		case "]":
+			depth--
+		default:
+			name := strings.TrimSuffix(token, "...")
+			sig.Params = append(sig.Params, &FunctionParam{Name: name, Optional: depth > 0, Variadic: name != token})
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.Errorf("invalid function signature: %s", signature)
+	}
+	return sig, nil
+}
@@ -0,0 +1,449 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/goflow/excellent"
+	"github.com/nyaruka/goflow/excellent/functions"
+	"github.com/nyaruka/goflow/excellent/gen"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/shopspring/decimal"
+)
+
+// DiagnosticType is the type of a problem found by type checking a template
+type DiagnosticType string
+
+// the types of problems found by type checking a template
+const (
+	DiagnosticUnknownPath DiagnosticType = "unknown_path"
+	DiagnosticArgCount    DiagnosticType = "arg_count"
+	DiagnosticArgType     DiagnosticType = "arg_type"
+)
+
+// Diagnostic is a problem found by type checking a template
+type Diagnostic struct {
+	Type     DiagnosticType
+	Offset   int    // offset in characters from the start of the template
+	Path     string // the context path if this is an unknown path
+	Function string // the function name if this is a problem with a function call
+	Message  string
+}
+
+// CheckTemplate type checks the given template against the given schema and returns the problems found. An
+// error is returned if any expressions in the template can't be parsed.
+func CheckTemplate(template string, allowedTopLevels []string, schema *Schema) ([]*Diagnostic, error) {
+	diagnostics := make([]*Diagnostic, 0)
+	errors := excellent.NewTemplateErrors()
+
+	scanner := excellent.NewXPositionScanner(strings.NewReader(template), allowedTopLevels)
+
+	for tokenType, token := scanner.Scan(); tokenType != excellent.EOF; tokenType, token = scanner.Scan() {
+		var repr string
+		var start int
+
+		switch tokenType {
+		case excellent.IDENTIFIER:
+			repr, start = "@"+token, scanner.Offset()+1
+		case excellent.EXPRESSION:
+			repr, start = "@("+token+")", scanner.Offset()+2
+		default:
+			continue
+		}
+
+		checker := &typeChecker{schema: schema, offset: start}
+
+		if _, err := excellent.VisitExpression(token, checker); err != nil {
+			// syntax errors are positioned relative to the start of the template
+			if syntaxErr, isSyntax := err.(*excellent.SyntaxError); isSyntax {
+				syntaxErr.Shift(template, start)
+			}
+			errors.AddError(repr, err)
+		}
+
+		diagnostics = append(diagnostics, checker.diagnostics...)
+	}
+
+	if errors.HasErrors() {
+		return diagnostics, errors
+	}
+	return diagnostics, nil
+}
+
+// names of the inferred types which don't exist in a schema
+const (
+	typeBoolean  = "boolean"
+	typeFunction = "function"
+)
+
+// the static type we've inferred for an expression. A nil type means we don't know.
+type checkedType struct {
+	name     string   // a schema type name or one of our own types above
+	array    bool     // whether this is an array of the named type
+	path     []string // the context path if this is a value from the context
+	literal  *string  // the value if this is a text or number literal
+	function string   // the function name if this is a function
+}
+
+func (t *checkedType) describe() string {
+	if t.array {
+		return "array"
+	}
+	if t.literal != nil && t.name == SchemaTypeText {
+		return strconv.Quote(*t.literal)
+	}
+	return t.name
+}
+
+// visitor which infers types and records problems
+type typeChecker struct {
+	gen.BaseExcellent2Visitor
+
+	schema      *Schema
+	offset      int
+	locals      map[string]bool
+	diagnostics []*Diagnostic
+}
+
+func (v *typeChecker) report(token antlr.Token, typ DiagnosticType, path, function, message string) {
+	v.diagnostics = append(v.diagnostics, &Diagnostic{
+		Type:     typ,
+		Offset:   v.offset + token.GetStart(),
+		Path:     path,
+		Function: function,
+		Message:  message,
+	})
+}
+
+// returns the inferred type of the given tree, or nil if it's unknown
+func (v *typeChecker) check(tree antlr.ParseTree) *checkedType {
+	t, _ := v.Visit(tree).(*checkedType)
+	return t
+}
+
+// Visit the top level parse tree
+func (v *typeChecker) Visit(tree antlr.ParseTree) interface{} {
+	return tree.Accept(v)
+}
+
+func (v *typeChecker) VisitChildren(node antlr.RuleNode) interface{} {
+	for _, c := range node.GetChildren() {
+		c.(antlr.ParseTree).Accept(v)
+	}
+	return nil
+}
+
+// VisitParse handles our top level parser
+func (v *typeChecker) VisitParse(ctx *gen.ParseContext) interface{} {
+	return v.Visit(ctx.Expression())
+}
+
+// VisitContextReference deals with root variables in the context
+func (v *typeChecker) VisitContextReference(ctx *gen.ContextReferenceContext) interface{} {
+	name := ctx.GetText()
+
+	if v.locals[strings.ToLower(name)] {
+		return nil
+	}
+
+	if functions.Lookup(name) != nil {
+		return &checkedType{name: typeFunction, function: strings.ToLower(name)}
+	}
+
+	prop := v.schema.Root(name)
+	if prop == nil {
+		v.report(ctx.GetStart(), DiagnosticUnknownPath, name, "", fmt.Sprintf("unknown context path %s", name))
+		return nil
+	}
+
+	return &checkedType{name: prop.Type, array: prop.Array, path: []string{name}}
+}
+
+// VisitDotLookup deals with lookups like foo.bar
+func (v *typeChecker) VisitDotLookup(ctx *gen.DotLookupContext) interface{} {
+	return v.lookup(v.check(ctx.Atom()), ctx.GetProperty().GetText(), ctx.GetProperty())
+}
+
+// VisitArrayLookup deals with lookups such as foo[5] or foo["key with spaces"]
+func (v *typeChecker) VisitArrayLookup(ctx *gen.ArrayLookupContext) interface{} {
+	atom := v.check(ctx.Atom())
+	key := v.check(ctx.Expression())
+
+	if key != nil && key.literal != nil {
+		return v.lookup(atom, *key.literal, ctx.Expression().GetStart())
+	}
+
+	// key isn't known so all we can know is the type of items in an array or dynamic object
+	if atom != nil {
+		if atom.array {
+			return &checkedType{name: atom.name}
+		}
+		if st := v.schema.Type(atom.name); st != nil && st.Dynamic {
+			return &checkedType{name: st.PropertyType.Type, array: st.PropertyType.Array}
+		}
+	}
+	return nil
+}
+
+// looks up the given key on a value of the given type
+func (v *typeChecker) lookup(t *checkedType, key string, token antlr.Token) *checkedType {
+	// only values from the context have types we can be sure of
+	if t == nil || t.path == nil || t.name == SchemaTypeAny {
+		return nil
+	}
+
+	path := make([]string, len(t.path), len(t.path)+1)
+	copy(path, t.path)
+	path = append(path, key)
+
+	unknown := func() *checkedType {
+		p := strings.Join(path, ".")
+		v.report(token, DiagnosticUnknownPath, p, "", fmt.Sprintf("unknown context path %s", p))
+		return nil
+	}
+
+	if t.array {
+		if _, err := strconv.Atoi(key); err != nil {
+			return unknown()
+		}
+		return &checkedType{name: t.name, path: path}
+	}
+
+	st := v.schema.Type(t.name)
+	if st == nil {
+		// primitive values don't have properties
+		return unknown()
+	}
+
+	var prop *SchemaProperty
+	if st.Dynamic {
+		prop = st.PropertyType
+	} else {
+		prop = st.Properties[strings.ToLower(key)]
+		if prop == nil {
+			return unknown()
+		}
+	}
+
+	return &checkedType{name: prop.Type, array: prop.Array, path: path}
+}
+
+// VisitFunctionCall deals with function calls like TITLE(foo.bar)
+func (v *typeChecker) VisitFunctionCall(ctx *gen.FunctionCallContext) interface{} {
+	function := v.check(ctx.Atom())
+
+	var args []antlr.ParserRuleContext
+	if ctx.Parameters() != nil {
+		for _, arg := range ctx.Parameters().(*gen.FunctionParametersContext).AllExpression() {
+			args = append(args, arg.(antlr.ParserRuleContext))
+		}
+	}
+
+	argTypes := make([]*checkedType, len(args))
+	for i, arg := range args {
+		argTypes[i] = v.check(arg)
+	}
+
+	if function == nil || function.function == "" {
+		return nil
+	}
+
+	sig := v.schema.Function(function.function)
+	if sig == nil {
+		return nil
+	}
+
+	min, max := sig.MinArgs(), sig.MaxArgs()
+	if len(args) < min || (max >= 0 && len(args) > max) {
+		v.report(ctx.GetStart(), DiagnosticArgCount, "", sig.Name, fmt.Sprintf("%s takes %s, got %d", sig.Name, describeArgCount(min, max), len(args)))
+		return nil
+	}
+
+	for i, arg := range args {
+		param := sig.Param(i)
+		if param == nil || argTypes[i] == nil {
+			continue
+		}
+
+		// extra arguments are passed to the last parameter if it's variadic
+		paramIndex := i
+		if paramIndex >= len(sig.Params) {
+			paramIndex = len(sig.Params) - 1
+		}
+
+		if expected := functions.LookupParamType(sig.Name, paramIndex); expected != functions.ParamTypeAny && !canBePassedAs(argTypes[i], expected) {
+			v.report(arg.GetStart(), DiagnosticArgType, "", sig.Name, fmt.Sprintf("%s expects a %s for %s, got %s", sig.Name, expected, param.Name, argTypes[i].describe()))
+		}
+	}
+
+	return nil
+}
+
+// VisitFunctionParameters deals with the parameters to a function call
+func (v *typeChecker) VisitFunctionParameters(ctx *gen.FunctionParametersContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitTextLiteral deals with string literals such as "asdf"
+func (v *typeChecker) VisitTextLiteral(ctx *gen.TextLiteralContext) interface{} {
+	unquoted, _ := strconv.Unquote(ctx.GetText())
+	return &checkedType{name: SchemaTypeText, literal: &unquoted}
+}
+
+// VisitNumberLiteral deals with numbers like 123 or 1.5
+func (v *typeChecker) VisitNumberLiteral(ctx *gen.NumberLiteralContext) interface{} {
+	number := ctx.GetText()
+	return &checkedType{name: SchemaTypeNumber, literal: &number}
+}
+
+// VisitTrue deals with the `true` reserved word
+func (v *typeChecker) VisitTrue(ctx *gen.TrueContext) interface{} {
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitFalse deals with the `false` reserved word
+func (v *typeChecker) VisitFalse(ctx *gen.FalseContext) interface{} {
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitNull deals with the `null` reserved word
+func (v *typeChecker) VisitNull(ctx *gen.NullContext) interface{} {
+	return nil
+}
+
+// VisitAtomReference deals with visiting a single atom in our expression
+func (v *typeChecker) VisitAtomReference(ctx *gen.AtomReferenceContext) interface{} {
+	return v.Visit(ctx.Atom())
+}
+
+// VisitParentheses deals with expressions in parentheses such as (1+2)
+func (v *typeChecker) VisitParentheses(ctx *gen.ParenthesesContext) interface{} {
+	return v.Visit(ctx.Expression())
+}
+
+// VisitAdditionOrSubtraction deals with addition and subtraction like 5+5 and 5-3
+func (v *typeChecker) VisitAdditionOrSubtraction(ctx *gen.AdditionOrSubtractionContext) interface{} {
+	// could be numbers, datetimes or durations
+	return v.VisitChildren(ctx)
+}
+
+// VisitMultiplicationOrDivision deals with division and multiplication such as 5*5 or 5/2
+func (v *typeChecker) VisitMultiplicationOrDivision(ctx *gen.MultiplicationOrDivisionContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: SchemaTypeNumber}
+}
+
+// VisitNegation deals with negations such as -5
+func (v *typeChecker) VisitNegation(ctx *gen.NegationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitExponent deals with exponenets such as 5^5
+func (v *typeChecker) VisitExponent(ctx *gen.ExponentContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: SchemaTypeNumber}
+}
+
+// VisitConcatenation deals with string concatenations like "foo" & "bar"
+func (v *typeChecker) VisitConcatenation(ctx *gen.ConcatenationContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: SchemaTypeText}
+}
+
+// VisitEquality deals with equality or inequality tests 5 = 5 and 5 != 5
+func (v *typeChecker) VisitEquality(ctx *gen.EqualityContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitComparison deals with visiting a comparison between two values, such as 5<3 or 3>5
+func (v *typeChecker) VisitComparison(ctx *gen.ComparisonContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitNot deals with boolean negations such as not x
+func (v *typeChecker) VisitNot(ctx *gen.NotContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitAnd deals with boolean conjunctions such as x and y
+func (v *typeChecker) VisitAnd(ctx *gen.AndContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitOr deals with boolean disjunctions such as x or y
+func (v *typeChecker) VisitOr(ctx *gen.OrContext) interface{} {
+	v.VisitChildren(ctx)
+	return &checkedType{name: typeBoolean}
+}
+
+// VisitCoalesce deals with null coalescing such as x ?? y
+func (v *typeChecker) VisitCoalesce(ctx *gen.CoalesceContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitConditional deals with conditionals such as x ? y : z
+func (v *typeChecker) VisitConditional(ctx *gen.ConditionalContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+// VisitAnonFunction deals with anonymous functions like (x) => x * 2
+func (v *typeChecker) VisitAnonFunction(ctx *gen.AnonFunctionContext) interface{} {
+	outer := v.locals
+	v.locals = make(map[string]bool, len(outer)+len(ctx.AllNAME()))
+	for name := range outer {
+		v.locals[name] = true
+	}
+	for _, name := range ctx.AllNAME() {
+		v.locals[strings.ToLower(name.GetText())] = true
+	}
+
+	v.Visit(ctx.Expression())
+
+	v.locals = outer
+	return &checkedType{name: typeFunction}
+}
+
+// checks whether a value of the given type could be passed to a parameter expecting the given type
+func canBePassedAs(t *checkedType, expected functions.ParamType) bool {
+	if t.array {
+		return false
+	}
+
+	switch expected {
+	case functions.ParamTypeNumber:
+		switch t.name {
+		case typeBoolean, typeFunction, SchemaTypeDatetime:
+			return false
+		case SchemaTypeText:
+			if t.literal != nil {
+				_, err := decimal.NewFromString(strings.TrimSpace(*t.literal))
+				return err == nil
+			}
+		}
+	case functions.ParamTypeFunction:
+		return t.name == typeFunction || t.name == SchemaTypeAny
+	}
+	return true
+}
+
+func describeArgCount(min, max int) string {
+	plural := func(n int) string {
+		if n == 1 {
+			return "1 argument"
+		}
+		return fmt.Sprintf("%d arguments", n)
+	}
+
+	if max < 0 {
+		return "at least " + plural(min)
+	} else if min == max {
+		return plural(min)
+	}
+	return fmt.Sprintf("%d to %d arguments", min, max)
+}
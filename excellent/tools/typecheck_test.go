@@ -0,0 +1,133 @@
+package tools_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/nyaruka/goflow/excellent/tools"
+	"github.com/nyaruka/goflow/flows"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readSchema(t *testing.T) *tools.Schema {
+	completionJSON, err := ioutil.ReadFile("../../docs/en_US/completion.json")
+	require.NoError(t, err)
+	functionsJSON, err := ioutil.ReadFile("../../docs/en_US/functions.json")
+	require.NoError(t, err)
+
+	schema, err := tools.ReadSchema(completionJSON, functionsJSON)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestParseFunctionSignature(t *testing.T) {
+	testCases := []struct {
+		signature string
+		params    []tools.FunctionParam
+		min, max  int
+	}{
+		{`now()`, []tools.FunctionParam{}, 0, 0},
+		{`upper(text)`, []tools.FunctionParam{{"text", false, false}}, 1, 1},
+		{`format_date(date, [,format])`, []tools.FunctionParam{{"date", false, false}, {"format", true, false}}, 1, 2},
+		{`format_datetime(datetime [,format [,timezone]])`, []tools.FunctionParam{{"datetime", false, false}, {"format", true, false}, {"timezone", true, false}}, 1, 3},
+		{`max(numbers...)`, []tools.FunctionParam{{"numbers", false, true}}, 1, -1},
+		{`array([values...])`, []tools.FunctionParam{{"values", true, true}}, 0, -1},
+		{`foreach(values, func, [args...])`, []tools.FunctionParam{{"values", false, false}, {"func", false, false}, {"args", true, true}}, 2, -1},
+	}
+
+	for _, tc := range testCases {
+		sig, err := tools.ParseFunctionSignature(tc.signature)
+		require.NoError(t, err, "unexpected error parsing %s", tc.signature)
+
+		params := make([]tools.FunctionParam, len(sig.Params))
+		for i, p := range sig.Params {
+			params[i] = *p
+		}
+
+		assert.Equal(t, tc.params, params, "params mismatch for %s", tc.signature)
+		assert.Equal(t, tc.min, sig.MinArgs(), "min args mismatch for %s", tc.signature)
+		assert.Equal(t, tc.max, sig.MaxArgs(), "max args mismatch for %s", tc.signature)
+	}
+
+	_, err := tools.ParseFunctionSignature(`upper`)
+	assert.EqualError(t, err, "invalid function signature: upper")
+
+	_, err = tools.ParseFunctionSignature(`upper([text)`)
+	assert.EqualError(t, err, "invalid function signature: upper([text)")
+}
+
+func TestCheckTemplate(t *testing.T) {
+	schema := readSchema(t)
+
+	testCases := []struct {
+		template    string
+		diagnostics []tools.Diagnostic
+		hasError    bool
+	}{
+		{``, nil, false},
+		{`Hi @contact.name, you are @fields.age and joined @(format_date(contact.created_on))`, nil, false},
+		{`@contact.groups @(contact.groups[0].name) @contact.urns.0 @results.favorite.category_localized`, nil, false},
+		{`@(upper(webhook.foo.bar)) @trigger.params.x @(LOWER(Contact.Name))`, nil, false},
+		{`@(foreach(contact.groups, (g) => g.name & g.foo)) @(filter(contact.urns, upper))`, nil, false},
+		{`@(abs("-12.5")) @(max(1, 2, 3)) @(round(contact.fields.age, 2)) @(rand_between(1, 10))`, nil, false},
+		{`@(if(contact.created_on > now(), "new", "old")) @(legacy_add(1, 2))`, nil, false},
+		{`Hi @contact.nmae`, []tools.Diagnostic{
+			{Type: tools.DiagnosticUnknownPath, Offset: 12, Path: "contact.nmae", Message: "unknown context path contact.nmae"},
+		}, false},
+		{`@(foo) @@contact.x @(contact.uuid.x)`, []tools.Diagnostic{
+			{Type: tools.DiagnosticUnknownPath, Offset: 2, Path: "foo", Message: "unknown context path foo"},
+			{Type: tools.DiagnosticUnknownPath, Offset: 34, Path: "contact.uuid.x", Message: "unknown context path contact.uuid.x"},
+		}, false},
+		{`@(contact.urns.foo) @(contact.groups[0]["nam"])`, []tools.Diagnostic{
+			{Type: tools.DiagnosticUnknownPath, Offset: 15, Path: "contact.urns.foo", Message: "unknown context path contact.urns.foo"},
+			{Type: tools.DiagnosticUnknownPath, Offset: 40, Path: "contact.groups.0.nam", Message: "unknown context path contact.groups.0.nam"},
+		}, false},
+		{`✓ @(upper()) @(upper("a", "b")) @(now(1)) @(format_datetime())`, []tools.Diagnostic{
+			{Type: tools.DiagnosticArgCount, Offset: 4, Function: "upper", Message: "upper takes 1 argument, got 0"},
+			{Type: tools.DiagnosticArgCount, Offset: 15, Function: "upper", Message: "upper takes 1 argument, got 2"},
+			{Type: tools.DiagnosticArgCount, Offset: 34, Function: "now", Message: "now takes 0 arguments, got 1"},
+			{Type: tools.DiagnosticArgCount, Offset: 44, Function: "format_datetime", Message: "format_datetime takes 1 to 3 arguments, got 0"},
+		}, false},
+		{`@(foreach()) @(max())`, []tools.Diagnostic{
+			{Type: tools.DiagnosticArgCount, Offset: 2, Function: "foreach", Message: "foreach takes at least 2 arguments, got 0"},
+			{Type: tools.DiagnosticArgCount, Offset: 15, Function: "max", Message: "max takes at least 1 argument, got 0"},
+		}, false},
+		{`@(array()) @(object()) @(max(1))`, nil, false},
+		{`@(abs("foo")) @(round(1.5, true)) @(max(1, contact.urns)) @(abs(contact.created_on))`, []tools.Diagnostic{
+			{Type: tools.DiagnosticArgType, Offset: 6, Function: "abs", Message: `abs expects a number for number, got "foo"`},
+			{Type: tools.DiagnosticArgType, Offset: 27, Function: "round", Message: "round expects a number for places, got boolean"},
+			{Type: tools.DiagnosticArgType, Offset: 43, Function: "max", Message: "max expects a number for numbers, got array"},
+			{Type: tools.DiagnosticArgType, Offset: 64, Function: "abs", Message: "abs expects a number for number, got datetime"},
+		}, false},
+		{`@(foreach(contact.groups, "upper")) @(filter(contact.urns, contact.name))`, []tools.Diagnostic{
+			{Type: tools.DiagnosticArgType, Offset: 26, Function: "foreach", Message: `foreach expects a function for func, got "upper"`},
+			{Type: tools.DiagnosticArgType, Offset: 59, Function: "filter", Message: "filter expects a function for func, got text"},
+		}, false},
+		{`@(contact.nmae) @(1 +) @(upper())`, []tools.Diagnostic{
+			{Type: tools.DiagnosticUnknownPath, Offset: 10, Path: "contact.nmae", Message: "unknown context path contact.nmae"},
+			{Type: tools.DiagnosticArgCount, Offset: 25, Function: "upper", Message: "upper takes 1 argument, got 0"},
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		diagnostics, err := tools.CheckTemplate(tc.template, flows.RunContextTopLevels, schema)
+
+		if tc.hasError {
+			assert.Error(t, err, "expected error for template: %s", tc.template)
+		} else {
+			assert.NoError(t, err, "unexpected error for template: %s, err: %s", tc.template, err)
+		}
+
+		actual := make([]tools.Diagnostic, len(diagnostics))
+		for i, d := range diagnostics {
+			actual[i] = *d
+		}
+		if tc.diagnostics == nil {
+			tc.diagnostics = []tools.Diagnostic{}
+		}
+
+		assert.Equal(t, tc.diagnostics, actual, "diagnostics mismatch for template: %s", tc.template)
+	}
+}
@@ -60,6 +60,8 @@ func Equals(x1 XValue, x2 XValue) bool {
 		return typed.Equals(x2.(XDate))
 	case XDateTime:
 		return typed.Equals(x2.(XDateTime))
+	case XDuration:
+		return typed.Equals(x2.(XDuration))
 	case XError:
 		return typed.Equals(x2.(XError))
 	case XFunction:
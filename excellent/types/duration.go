@@ -0,0 +1,250 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/dates"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// XDuration is a length of time made up of a number of months, a number of days and a fixed amount of time.
+// Months and days are kept separate from the rest so that adding a duration to a datetime respects calendar
+// months and daylight savings changes. Durations can be created with the [function:duration](#function:duration)
+// function, added to or subtracted from datetimes, and are the result of subtracting one datetime from another.
+//
+//   @(duration(2, "h")) -> PT2H
+//   @(format(duration(90, "m"))) -> 1h 30m
+//   @(datetime("2020-01-15T10:00:00Z") + duration(1, "M")) -> 2020-02-15T10:00:00.000000Z
+//   @(datetime("2020-01-02T10:00:00Z") - datetime("2020-01-01T09:30:00Z")) -> PT24H30M
+//   @(json(duration(3, "D"))) -> "P3D"
+//
+// @type duration
+type XDuration struct {
+	months int
+	days   int
+	time   time.Duration
+}
+
+// NewXDuration creates a new duration
+func NewXDuration(months, days int, duration time.Duration) XDuration {
+	return XDuration{months: months, days: days, time: duration}
+}
+
+// Describe returns a representation of this type for error messages
+func (x XDuration) Describe() string { return "duration" }
+
+// Truthy determines truthiness for this type
+func (x XDuration) Truthy() bool {
+	return x != XDurationZero
+}
+
+// Render returns the canonical text representation which is an ISO 8601 duration
+func (x XDuration) Render() string {
+	if x == XDurationZero {
+		return "PT0S"
+	}
+	if x.isNegative() {
+		return "-" + x.Negate().Render()
+	}
+
+	var sb strings.Builder
+	sb.WriteString("P")
+
+	if years := x.months / 12; years != 0 {
+		fmt.Fprintf(&sb, "%dY", years)
+	}
+	if months := x.months % 12; months != 0 {
+		fmt.Fprintf(&sb, "%dM", months)
+	}
+	if x.days != 0 {
+		fmt.Fprintf(&sb, "%dD", x.days)
+	}
+
+	if x.time != 0 {
+		hours, minutes, seconds := x.timeParts()
+
+		sb.WriteString("T")
+		if hours != 0 {
+			fmt.Fprintf(&sb, "%dH", hours)
+		}
+		if minutes != 0 {
+			fmt.Fprintf(&sb, "%dM", minutes)
+		}
+		if seconds.Sign() != 0 {
+			fmt.Fprintf(&sb, "%sS", seconds.String())
+		}
+	}
+
+	return sb.String()
+}
+
+// Format returns the pretty text representation
+func (x XDuration) Format(env envs.Environment) string {
+	if x == XDurationZero {
+		return "0s"
+	}
+	if x.isNegative() {
+		return "-" + x.Negate().Format(env)
+	}
+
+	parts := make([]string, 0, 6)
+	add := func(value int64, unit string) {
+		if value != 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", value, unit))
+		}
+	}
+
+	add(int64(x.months/12), "Y")
+	add(int64(x.months%12), "M")
+	add(int64(x.days), "D")
+
+	hours, minutes, seconds := x.timeParts()
+	add(hours, "h")
+	add(minutes, "m")
+	if seconds.Sign() != 0 {
+		parts = append(parts, seconds.String()+"s")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// MarshalJSON is called when a struct containing this type is marshaled
+func (x XDuration) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(x.Render())
+}
+
+// String returns the native string representation of this type
+func (x XDuration) String() string {
+	return fmt.Sprintf(`XDuration(%d, %d, %s)`, x.months, x.days, x.time)
+}
+
+// Months returns the months part of this duration
+func (x XDuration) Months() int { return x.months }
+
+// Days returns the days part of this duration
+func (x XDuration) Days() int { return x.days }
+
+// Time returns the fixed time part of this duration
+func (x XDuration) Time() time.Duration { return x.time }
+
+// Equals determines equality for this type
+func (x XDuration) Equals(other XDuration) bool {
+	return x == other
+}
+
+// Add returns the sum of this duration and another
+func (x XDuration) Add(other XDuration) XDuration {
+	return NewXDuration(x.months+other.months, x.days+other.days, x.time+other.time)
+}
+
+// Negate returns the negation of this duration
+func (x XDuration) Negate() XDuration {
+	return NewXDuration(-x.months, -x.days, -x.time)
+}
+
+// AddToDateTime adds this duration to the given datetime
+func (x XDuration) AddToDateTime(dt XDateTime) XDateTime {
+	return NewXDateTime(dt.Native().AddDate(0, x.months, x.days).Add(x.time))
+}
+
+// AddToDate adds this duration to the given date. It's an error if this duration has a time part.
+func (x XDuration) AddToDate(d XDate) (XDate, XError) {
+	if x.time != 0 {
+		return XDateZero, NewXErrorf("can't add a duration with a time part to a date")
+	}
+
+	dt := d.Native().Combine(dates.ZeroTimeOfDay, time.UTC).AddDate(0, x.months, x.days)
+	return NewXDate(dates.ExtractDate(dt)), nil
+}
+
+// whether every part of this duration is zero or negative, in which case we render it with a leading minus sign
+func (x XDuration) isNegative() bool {
+	return x.months <= 0 && x.days <= 0 && x.time <= 0 && x != XDurationZero
+}
+
+// splits the fixed time part of this duration into hours, minutes and seconds
+func (x XDuration) timeParts() (int64, int64, decimal.Decimal) {
+	hours := int64(x.time / time.Hour)
+	minutes := int64((x.time % time.Hour) / time.Minute)
+	seconds := decimal.New(int64(x.time%time.Minute), -9)
+	return hours, minutes, seconds
+}
+
+// XDurationZero is the zero duration value
+var XDurationZero = NewXDuration(0, 0, 0)
+var _ XValue = XDurationZero
+
+// DurationBetween returns the fixed duration between two datetimes
+func DurationBetween(dt1 XDateTime, dt2 XDateTime) XDuration {
+	return NewXDuration(0, 0, dt1.Native().Sub(dt2.Native()))
+}
+
+var isoDurationRegex = regexp.MustCompile(`^P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseXDuration parses an ISO 8601 duration such as P1DT12H
+func ParseXDuration(s string) (XDuration, error) {
+	negate := strings.HasPrefix(s, "-")
+	if negate {
+		s = s[1:]
+	}
+
+	match := isoDurationRegex.FindStringSubmatch(s)
+	if match == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return XDurationZero, errors.Errorf("%s is not a valid ISO 8601 duration", s)
+	}
+
+	num := func(i int) int {
+		n, _ := strconv.Atoi(match[i])
+		return n
+	}
+
+	months := num(1)*12 + num(2)
+	days := num(3)*7 + num(4)
+	duration := time.Duration(num(5))*time.Hour + time.Duration(num(6))*time.Minute
+
+	if match[7] != "" {
+		seconds, err := decimal.NewFromString(match[7])
+		if err != nil {
+			return XDurationZero, err
+		}
+		duration += time.Duration(seconds.Shift(9).IntPart())
+	}
+
+	d := NewXDuration(months, days, duration)
+	if negate {
+		d = d.Negate()
+	}
+	return d, nil
+}
+
+// ToXDuration converts the given value to a duration or returns an error if that isn't possible
+func ToXDuration(env envs.Environment, x XValue) (XDuration, XError) {
+	if !utils.IsNil(x) {
+		switch typed := x.(type) {
+		case XError:
+			return XDurationZero, typed
+		case XDuration:
+			return typed, nil
+		case XText:
+			parsed, err := ParseXDuration(typed.Native())
+			if err == nil {
+				return parsed, nil
+			}
+		case *XObject:
+			if typed.hasDefault() {
+				return ToXDuration(env, typed.Default())
+			}
+		}
+	}
+
+	return XDurationZero, NewXErrorf("unable to convert %s to a duration", Describe(x))
+}
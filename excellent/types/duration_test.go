@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/utils/dates"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXDuration(t *testing.T) {
+	env := envs.NewBuilder().Build()
+
+	d1 := types.NewXDuration(14, 3, 4*time.Hour+5*time.Minute+6500*time.Millisecond)
+	assert.Equal(t, `duration`, d1.Describe())
+	assert.True(t, d1.Truthy())
+	assert.False(t, types.XDurationZero.Truthy())
+	assert.Equal(t, `P1Y2M3DT4H5M6.5S`, d1.Render())
+	assert.Equal(t, `1Y 2M 3D 4h 5m 6.5s`, d1.Format(env))
+	assert.Equal(t, `XDuration(14, 3, 4h5m6.5s)`, d1.String())
+	assert.Equal(t, 14, d1.Months())
+	assert.Equal(t, 3, d1.Days())
+	assert.Equal(t, 4*time.Hour+5*time.Minute+6500*time.Millisecond, d1.Time())
+
+	assert.Equal(t, `PT0S`, types.XDurationZero.Render())
+	assert.Equal(t, `0s`, types.XDurationZero.Format(env))
+	assert.Equal(t, `-PT1H30M`, types.NewXDuration(0, 0, -90*time.Minute).Render())
+	assert.Equal(t, `-1h 30m`, types.NewXDuration(0, 0, -90*time.Minute).Format(env))
+	assert.Equal(t, `-P1DT2H`, types.NewXDuration(0, -1, -2*time.Hour).Render())
+	assert.Equal(t, `P1M-2D`, types.NewXDuration(1, -2, 0).Render())
+	assert.Equal(t, `P5D`, types.NewXDuration(0, 5, 0).Render())
+
+	marshaled, err := jsonx.Marshal(d1)
+	assert.NoError(t, err)
+	assert.Equal(t, `"P1Y2M3DT4H5M6.5S"`, string(marshaled))
+
+	// test equality
+	assert.True(t, d1.Equals(types.NewXDuration(14, 3, 4*time.Hour+5*time.Minute+6500*time.Millisecond)))
+	assert.False(t, d1.Equals(types.NewXDuration(14, 3, 0)))
+
+	// test arithmetic
+	assert.Equal(t, types.NewXDuration(1, 2, time.Hour), types.NewXDuration(1, 0, 0).Add(types.NewXDuration(0, 2, time.Hour)))
+	assert.Equal(t, types.NewXDuration(-1, -2, -time.Hour), types.NewXDuration(1, 2, time.Hour).Negate())
+
+	dt := types.NewXDateTime(time.Date(2020, 3, 7, 10, 30, 0, 0, time.UTC))
+	assert.Equal(t, types.NewXDateTime(time.Date(2020, 4, 9, 12, 0, 0, 0, time.UTC)), types.NewXDuration(1, 2, 90*time.Minute).AddToDateTime(dt))
+
+	d, xerr := types.NewXDuration(1, 2, 0).AddToDate(types.NewXDate(dates.NewDate(2020, 3, 7)))
+	assert.Nil(t, xerr)
+	assert.Equal(t, types.NewXDate(dates.NewDate(2020, 4, 9)), d)
+
+	_, xerr = types.NewXDuration(0, 0, time.Hour).AddToDate(types.NewXDate(dates.NewDate(2020, 3, 7)))
+	assert.EqualError(t, xerr, "can't add a duration with a time part to a date")
+
+	between := types.DurationBetween(dt, types.NewXDateTime(time.Date(2020, 3, 6, 9, 0, 0, 0, time.UTC)))
+	assert.Equal(t, types.NewXDuration(0, 0, 25*time.Hour+30*time.Minute), between)
+}
+
+func TestParseXDuration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected types.XDuration
+		hasError bool
+	}{
+		{"P1Y2M3DT4H5M6S", types.NewXDuration(14, 3, 4*time.Hour+5*time.Minute+6*time.Second), false},
+		{"P2W", types.NewXDuration(0, 14, 0), false},
+		{"PT1.5S", types.NewXDuration(0, 0, 1500*time.Millisecond), false},
+		{"PT0S", types.XDurationZero, false},
+		{"P1M-2D", types.NewXDuration(1, -2, 0), false},
+		{"-P1DT2H", types.NewXDuration(0, -1, -2*time.Hour), false},
+		{"P", types.XDurationZero, true},
+		{"P1DT", types.XDurationZero, true},
+		{"1D", types.XDurationZero, true},
+		{"P1H", types.XDurationZero, true},
+	}
+
+	for _, tc := range tests {
+		parsed, err := types.ParseXDuration(tc.value)
+
+		if tc.hasError {
+			assert.Error(t, err, "expected error for input %s", tc.value)
+		} else {
+			assert.NoError(t, err, "unexpected error for input %s", tc.value)
+			assert.Equal(t, tc.expected, parsed, "result mismatch for input %s", tc.value)
+		}
+	}
+}
+
+func TestToXDuration(t *testing.T) {
+	var tests = []struct {
+		value    types.XValue
+		expected types.XDuration
+		hasError bool
+	}{
+		{nil, types.XDurationZero, true},
+		{types.NewXError(errors.Errorf("Error")), types.XDurationZero, true},
+		{types.NewXNumberFromInt(123), types.XDurationZero, true},
+		{types.NewXText("P3D"), types.NewXDuration(0, 3, 0), false},
+		{types.NewXText("wha?"), types.XDurationZero, true},
+		{types.NewXDuration(1, 2, time.Hour), types.NewXDuration(1, 2, time.Hour), false},
+		{types.NewXObject(map[string]types.XValue{
+			"__default__": types.NewXText("PT2H"), // should use default
+			"foo":         types.NewXNumberFromInt(234),
+		}), types.NewXDuration(0, 0, 2*time.Hour), false},
+	}
+
+	env := envs.NewBuilder().Build()
+
+	for _, test := range tests {
+		result, err := types.ToXDuration(env, test.value)
+
+		if test.hasError {
+			assert.Error(t, err, "expected error for input %T{%s}", test.value, test.value)
+		} else {
+			assert.NoError(t, err, "unexpected error for input %T{%s}", test.value, test.value)
+			assert.Equal(t, test.expected, result, "result mismatch for input %T{%s}", test.value, test.value)
+		}
+	}
+}
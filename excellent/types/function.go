@@ -5,11 +5,14 @@ import (
 	"github.com/nyaruka/goflow/utils/jsonx"
 )
 
-// XFunction is a callable function.
+// XFunction is a callable function. As well as the built-in functions, anonymous functions can be
+// defined inline with the syntax `(args) => expression`.
 //
 //   @(upper) -> function
 //   @(array(upper)[0]("abc")) -> ABC
 //   @(json(upper)) -> null
+//   @(((x) => x * 2)(3)) -> 6
+//   @(foreach(array(1, 2), (x) => x + 1)) -> [2, 3]
 //
 // @type function
 type XFunction func(env envs.Environment, args ...XValue) XValue
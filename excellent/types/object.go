@@ -28,10 +28,11 @@ type XObject struct {
 	XValue
 	XCountable
 
-	def            XValue
-	props          map[string]XValue
-	source         func() map[string]XValue
-	marshalDefault bool
+	def              XValue
+	props            map[string]XValue
+	source           func() map[string]XValue
+	marshalDefault   bool
+	marshalAsDefault bool
 }
 
 // NewXObject returns a new object with the given properties
@@ -94,6 +95,10 @@ func (x *XObject) Format(env envs.Environment) string {
 
 // MarshalJSON converts this type to internal JSON
 func (x *XObject) MarshalJSON() ([]byte, error) {
+	if x.hasDefault() && x.marshalAsDefault {
+		return jsonx.Marshal(x.def)
+	}
+
 	marshaled := make(map[string]json.RawMessage, x.Count())
 	for p, v := range x.properties() {
 		asJSON, err := ToXJSON(v)
@@ -209,6 +214,11 @@ func (x *XObject) SetMarshalDefault(marshal bool) {
 	x.marshalDefault = marshal
 }
 
+// SetMarshalAsDefault sets whether this object should be marshaled as just its default value
+func (x *XObject) SetMarshalAsDefault(marshal bool) {
+	x.marshalAsDefault = marshal
+}
+
 // Default returns the default value for this
 func (x *XObject) hasDefault() bool {
 	return x.Default() != x
@@ -44,6 +44,11 @@ func TestXObject(t *testing.T) {
 	asJSON, _ = types.ToXJSON(object)
 	assert.Equal(t, types.NewXText(`{"bar":123,"foo":"abc","xxx":null,"zed":false}`), asJSON)
 
+	// or used in place of the properties
+	object.SetMarshalAsDefault(true)
+	asJSON, _ = types.ToXJSON(object)
+	assert.Equal(t, types.NewXText(`{"bar":123,"foo":"abc","xxx":null,"zed":false}`), asJSON)
+
 	// test equality
 	test.AssertXEqual(t, object, types.NewXObject(map[string]types.XValue{
 		"foo": types.NewXText("abc"),
@@ -105,6 +110,10 @@ func TestXObjectWithDefault(t *testing.T) {
 	asJSON, _ = types.ToXJSON(object)
 	assert.Equal(t, types.NewXText(`{"__default__":"abc-123","bar":123,"foo":"abc","zed":false}`), asJSON)
 
+	object.SetMarshalAsDefault(true)
+	asJSON, _ = types.ToXJSON(object)
+	assert.Equal(t, types.NewXText(`"abc-123"`), asJSON)
+
 	// test equality
 	test.AssertXEqual(t, object, types.NewXObject(map[string]types.XValue{
 		"__default__": types.NewXText("abc-123"),
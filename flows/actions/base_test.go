@@ -266,7 +266,7 @@ func testActionType(t *testing.T, assetsJSON json.RawMessage, typeName string) {
 			actual.LocalizedText = flow.ExtractLocalizables()
 		}
 		if tc.Inspection != nil {
-			actual.Inspection, _ = jsonx.Marshal(flow.Inspect(sa))
+			actual.Inspection, _ = jsonx.Marshal(flow.Inspect(sa, nil))
 		}
 
 		if !test.UpdateSnapshots {
@@ -86,11 +86,10 @@ func (a *SendMsgAction) Execute(run flows.FlowRun, step flows.Step, logModifier
 
 	sa := run.Session().Assets()
 
-	// create a new message for each URN+channel destination
-	for _, dest := range destinations {
-		var channelRef *assets.ChannelReference
-		if dest.Channel != nil {
-			channelRef = assets.NewChannelReference(dest.Channel.UUID(), dest.Channel.Name())
+	msgs := flows.NewMsgsOutForDestinations(destinations, func(urn urns.URN, channelRef *assets.ChannelReference) *flows.MsgOut {
+		// a message without a destination doesn't get templating or a topic
+		if urn == urns.NilURN {
+			return flows.NewMsgOut(urn, nil, evaluatedText, evaluatedAttachments, evaluatedQuickReplies, nil, flows.NilMsgTopic, step.WantsResponse())
 		}
 
 		var templating *flows.MsgTemplating
@@ -122,14 +121,10 @@ func (a *SendMsgAction) Execute(run flows.FlowRun, step flows.Step, logModifier
 			}
 		}
 
-		msg := flows.NewMsgOut(dest.URN.URN(), channelRef, evaluatedText, evaluatedAttachments, evaluatedQuickReplies, templating, a.Topic, step.WantsResponse())
-		logEvent(events.NewMsgCreated(msg))
-	}
+		return flows.NewMsgOut(urn, channelRef, evaluatedText, evaluatedAttachments, evaluatedQuickReplies, templating, a.Topic, step.WantsResponse())
+	})
 
-	// if we couldn't find a destination, create a msg without a URN or channel and it's up to the caller
-	// to handle that as they want
-	if len(destinations) == 0 {
-		msg := flows.NewMsgOut(urns.NilURN, nil, evaluatedText, evaluatedAttachments, evaluatedQuickReplies, nil, flows.NilMsgTopic, step.WantsResponse())
+	for _, msg := range msgs {
 		logEvent(events.NewMsgCreated(msg))
 	}
 
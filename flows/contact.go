@@ -56,6 +56,7 @@ type Contact struct {
 	urns      URNList
 	groups    *GroupList
 	fields    FieldValues
+	history   FieldHistory
 
 	// transient fields
 	assets SessionAssets
@@ -95,6 +96,7 @@ func NewContact(
 		urns:      urnList,
 		groups:    groupList,
 		fields:    fieldValues,
+		history:   make(FieldHistory),
 		assets:    sa,
 	}, nil
 }
@@ -111,6 +113,7 @@ func NewEmptyContact(sa SessionAssets, name string, language envs.Language, time
 		urns:      URNList{},
 		groups:    NewGroupList(sa, nil, assets.IgnoreMissing),
 		fields:    make(FieldValues),
+		history:   make(FieldHistory),
 		assets:    sa,
 	}
 }
@@ -132,6 +135,7 @@ func (c *Contact) Clone() *Contact {
 		urns:      c.urns.clone(),
 		groups:    c.groups.clone(),
 		fields:    c.fields.clone(),
+		history:   c.history.clone(),
 		assets:    c.assets,
 	}
 }
@@ -255,6 +259,21 @@ func (c *Contact) HasURN(urn urns.URN) bool {
 // Fields returns this contact's field values
 func (c *Contact) Fields() FieldValues { return c.fields }
 
+// FieldHistory returns the last change of each of this contact's fields which has been changed
+func (c *Contact) FieldHistory() FieldHistory { return c.history }
+
+// ChangeField sets the value of the given field (can be nil to clear it), recording the current value as its
+// previous value and when it was changed
+func (c *Contact) ChangeField(field *Field, value *Value, changedOn time.Time) {
+	c.history[field.Key()] = NewFieldChange(field, c.fields.Get(field), changedOn)
+	c.fields.Set(field, value)
+}
+
+// FieldsContext returns the field values of this contact, along with their last changes, for use in expressions
+func (c *Contact) FieldsContext(env envs.Environment) map[string]types.XValue {
+	return c.fields.context(env, c.history)
+}
+
 // Groups returns the groups that this contact belongs to
 func (c *Contact) Groups() *GroupList { return c.groups }
 
@@ -328,7 +347,7 @@ func (c *Contact) Context(env envs.Environment) map[string]types.XValue {
 		"urns":        c.urns.ToXValue(env),
 		"urn":         urn,
 		"groups":      c.groups.ToXValue(env),
-		"fields":      Context(env, c.Fields()),
+		"fields":      ContextFunc(env, c.FieldsContext),
 		"channel":     Context(env, c.PreferredChannel()),
 	}
 }
@@ -537,18 +556,26 @@ var _ assets.Reference = (*ContactReference)(nil)
 //------------------------------------------------------------------------------------------
 
 type contactEnvelope struct {
-	UUID      ContactUUID              `json:"uuid"                validate:"required,uuid4"`
-	ID        ContactID                `json:"id,omitempty"`
-	Name      string                   `json:"name,omitempty"`
-	Language  envs.Language            `json:"language,omitempty"`
-	Status    ContactStatus            `json:"status,omitempty"    validate:"omitempty,contact_status"`
-	Stopped   bool                     `json:"stopped,omitempty"`
-	Blocked   bool                     `json:"blocked,omitempty"`
-	Timezone  string                   `json:"timezone,omitempty"`
-	CreatedOn time.Time                `json:"created_on"          validate:"required"`
-	URNs      []urns.URN               `json:"urns,omitempty"      validate:"dive,urn"`
-	Groups    []*assets.GroupReference `json:"groups,omitempty"    validate:"dive"`
-	Fields    map[string]*Value        `json:"fields,omitempty"`
+	UUID      ContactUUID                    `json:"uuid"                validate:"required,uuid4"`
+	ID        ContactID                      `json:"id,omitempty"`
+	Name      string                         `json:"name,omitempty"`
+	Language  envs.Language                  `json:"language,omitempty"`
+	Status    ContactStatus                  `json:"status,omitempty"    validate:"omitempty,contact_status"`
+	Stopped   bool                           `json:"stopped,omitempty"`
+	Blocked   bool                           `json:"blocked,omitempty"`
+	Timezone  string                         `json:"timezone,omitempty"`
+	CreatedOn time.Time                      `json:"created_on"          validate:"required"`
+	URNs      []urns.URN                     `json:"urns,omitempty"      validate:"dive,urn"`
+	Groups    []*assets.GroupReference       `json:"groups,omitempty"    validate:"dive"`
+	Fields    map[string]*fieldValueEnvelope `json:"fields,omitempty"`
+}
+
+// a field value is written as its value, plus its previous value and when it was changed if it has been changed. A
+// field which has been cleared has only its previous value and when it was changed.
+type fieldValueEnvelope struct {
+	*Value
+	Previous  *Value     `json:"previous,omitempty"`
+	ChangedOn *time.Time `json:"changed_on,omitempty"`
 }
 
 // ReadContact decodes a contact from the passed in JSON
@@ -589,7 +616,24 @@ func ReadContact(sa SessionAssets, data json.RawMessage, missing assets.MissingC
 	}
 
 	c.groups = NewGroupList(sa, envelope.Groups, missing)
-	c.fields = NewFieldValues(sa, envelope.Fields, missing)
+	values := make(map[string]*Value, len(envelope.Fields))
+	for key, v := range envelope.Fields {
+		if v != nil {
+			values[key] = v.Value
+		}
+	}
+
+	c.fields = NewFieldValues(sa, values, missing)
+	c.history = make(FieldHistory)
+
+	for key, v := range envelope.Fields {
+		if v == nil || v.ChangedOn == nil {
+			continue
+		}
+		if field := sa.Fields().Get(key); field != nil {
+			c.history[key] = NewFieldChange(field, v.Previous, *v.ChangedOn)
+		}
+	}
 
 	return c, nil
 }
@@ -615,11 +659,22 @@ func (c *Contact) MarshalJSON() ([]byte, error) {
 		ce.Groups[i] = group.Reference()
 	}
 
-	ce.Fields = make(map[string]*Value)
+	ce.Fields = make(map[string]*fieldValueEnvelope)
 	for _, v := range c.fields {
 		if v != nil {
-			ce.Fields[v.field.Key()] = v.Value
+			ce.Fields[v.field.Key()] = &fieldValueEnvelope{Value: v.Value}
+		}
+	}
+	for key, change := range c.history {
+		fe := ce.Fields[key]
+		if fe == nil {
+			fe = &fieldValueEnvelope{}
+			ce.Fields[key] = fe
 		}
+
+		changedOn := change.changedOn
+		fe.Previous = change.previous
+		fe.ChangedOn = &changedOn
 	}
 
 	return jsonx.Marshal(ce)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/flows/definition/migrations"
@@ -39,6 +40,7 @@ type flow struct {
 	expireAfterMinutes int
 	localization       flows.Localization
 	nodes              []flows.Node
+	payloadSchema      *jsonx.Schema
 
 	// optional properties not used by engine itself
 	ui json.RawMessage
@@ -47,8 +49,16 @@ type flow struct {
 	nodeMap map[flows.NodeUUID]flows.Node
 }
 
+// FlowOption is an optional property which can be set when creating a new flow
+type FlowOption func(*flow)
+
+// WithPayloadSchema sets the JSON schema which payloads of API triggers for the flow must validate against
+func WithPayloadSchema(schema *jsonx.Schema) FlowOption {
+	return func(f *flow) { f.payloadSchema = schema }
+}
+
 // NewFlow creates a new flow
-func NewFlow(uuid assets.FlowUUID, name string, language envs.Language, flowType flows.FlowType, revision int, expireAfterMinutes int, localization flows.Localization, nodes []flows.Node, ui json.RawMessage) (flows.Flow, error) {
+func NewFlow(uuid assets.FlowUUID, name string, language envs.Language, flowType flows.FlowType, revision int, expireAfterMinutes int, localization flows.Localization, nodes []flows.Node, ui json.RawMessage, options ...FlowOption) (flows.Flow, error) {
 	f := &flow{
 		uuid:               uuid,
 		name:               name,
@@ -63,6 +73,10 @@ func NewFlow(uuid assets.FlowUUID, name string, language envs.Language, flowType
 		ui:                 ui,
 	}
 
+	for _, option := range options {
+		option(f)
+	}
+
 	for _, node := range f.nodes {
 		f.nodeMap[node.UUID()] = node
 	}
@@ -83,6 +97,7 @@ func (f *flow) Type() flows.FlowType                   { return f.flowType }
 func (f *flow) ExpireAfterMinutes() int                { return f.expireAfterMinutes }
 func (f *flow) Nodes() []flows.Node                    { return f.nodes }
 func (f *flow) Localization() flows.Localization       { return f.localization }
+func (f *flow) PayloadSchema() *jsonx.Schema           { return f.payloadSchema }
 func (f *flow) UI() json.RawMessage                    { return f.ui }
 func (f *flow) GetNode(uuid flows.NodeUUID) flows.Node { return f.nodeMap[uuid] }
 
@@ -105,8 +120,8 @@ func (f *flow) validate() error {
 	return nil
 }
 
-// Inspect enumerates dependencies, results etc
-func (f *flow) Inspect(sa flows.SessionAssets) *flows.Inspection {
+// Inspect enumerates dependencies, results etc. If a schema is provided then templates are also type checked against it.
+func (f *flow) Inspect(sa flows.SessionAssets, schema *tools.Schema) *flows.Inspection {
 	templates, assetRefs, parentRefs := f.extract()
 
 	return &flows.Inspection{
@@ -114,7 +129,7 @@ func (f *flow) Inspect(sa flows.SessionAssets) *flows.Inspection {
 		Results:      flows.NewResultSpecs(f.extractResults()),
 		WaitingExits: f.extractExitsFromWaits(),
 		ParentRefs:   parentRefs,
-		Issues:       issues.Check(sa, f, templates, assetRefs),
+		Issues:       issues.Check(sa, f, templates, assetRefs, schema),
 	}
 }
 
@@ -314,6 +329,7 @@ type flowEnvelope struct {
 	ExpireAfterMinutes int             `json:"expire_after_minutes"`
 	Localization       localization    `json:"localization"`
 	Nodes              []*node         `json:"nodes"`
+	PayloadSchema      json.RawMessage `json:"payload_schema,omitempty"`
 	UI                 json.RawMessage `json:"_ui,omitempty"`
 }
 
@@ -346,7 +362,16 @@ func ReadFlow(data json.RawMessage, migrationConfig *migrations.Config) (flows.F
 		e.Localization = make(localization)
 	}
 
-	return NewFlow(e.UUID, e.Name, e.Language, e.Type, e.Revision, e.ExpireAfterMinutes, e.Localization, nodes, e.UI)
+	options := make([]FlowOption, 0, 1)
+	if e.PayloadSchema != nil {
+		payloadSchema, err := jsonx.ReadSchema(e.PayloadSchema)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid payload schema")
+		}
+		options = append(options, WithPayloadSchema(payloadSchema))
+	}
+
+	return NewFlow(e.UUID, e.Name, e.Language, e.Type, e.Revision, e.ExpireAfterMinutes, e.Localization, nodes, e.UI, options...)
 }
 
 // MarshalJSON marshals this flow into JSON
@@ -370,5 +395,12 @@ func (f *flow) MarshalJSON() ([]byte, error) {
 		e.Nodes[i] = f.nodes[i].(*node)
 	}
 
+	if f.payloadSchema != nil {
+		var err error
+		if e.PayloadSchema, err = jsonx.Marshal(f.payloadSchema); err != nil {
+			return nil, err
+		}
+	}
+
 	return jsonx.Marshal(e)
 }
@@ -277,7 +277,7 @@ func TestNewFlow(t *testing.T) {
 	}), flows.Context(session.Environment(), flow))
 
 	// check inspection
-	info := flow.Inspect(session.Assets())
+	info := flow.Inspect(session.Assets(), nil)
 	infoJSON, _ := jsonx.Marshal(info)
 
 	test.AssertEqualJSON(t, []byte(`{
@@ -336,7 +336,7 @@ func TestEmptyFlow(t *testing.T) {
   	}`, definition.CurrentSpecVersion)
 	test.AssertEqualJSON(t, []byte(expected), marshaled, "flow definition mismatch")
 
-	info := flow.Inspect(nil)
+	info := flow.Inspect(nil, nil)
 	infoJSON, _ := jsonx.Marshal(info)
 
 	test.AssertEqualJSON(t, []byte(`{
@@ -605,7 +605,7 @@ func TestInspection(t *testing.T) {
 		flow, err := sa.Flows().Get(assets.FlowUUID(tc.uuid))
 		require.NoError(t, err)
 
-		actualInfo := flow.Inspect(sa)
+		actualInfo := flow.Inspect(sa, nil)
 		actualJSON, _ := jsonx.MarshalPretty(actualInfo)
 
 		testDataPath := "testdata/inspection/" + tc.path[strings.LastIndex(tc.path, "/"):]
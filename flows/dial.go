@@ -0,0 +1,32 @@
+package flows
+
+// DialStatus is the type for different dial statuses
+type DialStatus string
+
+// possible dial statuses
+const (
+	DialStatusAnswered DialStatus = "answered"
+	DialStatusNoAnswer DialStatus = "no_answer"
+	DialStatusBusy     DialStatus = "busy"
+	DialStatusFailed   DialStatus = "failed"
+)
+
+// Dial is the result of a dial wait, i.e. the outcome of connecting the caller to another number
+type Dial struct {
+	Status   DialStatus `json:"status"   validate:"required,eq=answered|eq=no_answer|eq=busy|eq=failed"`
+	Duration int        `json:"duration" validate:"min=0"`
+}
+
+// NewDial creates a new dial
+func NewDial(status DialStatus, duration int) *Dial {
+	return &Dial{Status: status, Duration: duration}
+}
+
+// ConnectionStatus is the type for changes in the status of a voice connection which can resume a session
+type ConnectionStatus string
+
+// possible connection statuses
+const (
+	ConnectionStatusHangup            ConnectionStatus = "hangup"
+	ConnectionStatusTransferCompleted ConnectionStatus = "transfer_completed"
+)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/excellent"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/utils/uuids"
 )
@@ -13,6 +14,8 @@ type engine struct {
 	services          *services
 	maxStepsPerSprint int
 	maxTemplateChars  int
+	caseTracing       bool
+	templateCache     *excellent.TemplateCache
 }
 
 // NewSession creates a new session
@@ -37,9 +40,11 @@ func (e *engine) ReadSession(sa flows.SessionAssets, data json.RawMessage, missi
 	return readSession(e, sa, data, missing)
 }
 
-func (e *engine) Services() flows.Services { return e.services }
-func (e *engine) MaxStepsPerSprint() int   { return e.maxStepsPerSprint }
-func (e *engine) MaxTemplateChars() int    { return e.maxTemplateChars }
+func (e *engine) Services() flows.Services                { return e.services }
+func (e *engine) MaxStepsPerSprint() int                  { return e.maxStepsPerSprint }
+func (e *engine) MaxTemplateChars() int                   { return e.maxTemplateChars }
+func (e *engine) CaseTracing() bool                       { return e.caseTracing }
+func (e *engine) TemplateCache() *excellent.TemplateCache { return e.templateCache }
 
 var _ flows.Engine = (*engine)(nil)
 
@@ -59,6 +64,7 @@ func NewBuilder() *Builder {
 			services:          newEmptyServices(),
 			maxStepsPerSprint: 100,
 			maxTemplateChars:  10000,
+			templateCache:     excellent.NewTemplateCache(1000),
 		},
 	}
 }
@@ -105,5 +111,21 @@ func (b *Builder) WithMaxTemplateChars(max int) *Builder {
 	return b
 }
 
+// WithCaseTracing sets whether switch routers should record the results of evaluating their cases
+func (b *Builder) WithCaseTracing(enabled bool) *Builder {
+	b.eng.caseTracing = enabled
+	return b
+}
+
+// WithTemplateCacheSize sets how many compiled templates are kept for reuse, where zero disables caching
+func (b *Builder) WithTemplateCacheSize(size int) *Builder {
+	if size > 0 {
+		b.eng.templateCache = excellent.NewTemplateCache(size)
+	} else {
+		b.eng.templateCache = nil
+	}
+	return b
+}
+
 // Build returns the final engine
 func (b *Builder) Build() flows.Engine { return b.eng }
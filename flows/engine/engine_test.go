@@ -16,6 +16,8 @@ func TestBuilder(t *testing.T) {
 	eng := engine.NewBuilder().WithMaxStepsPerSprint(123).Build()
 
 	assert.Equal(t, 123, eng.MaxStepsPerSprint())
+	assert.False(t, eng.CaseTracing())
+	assert.NotNil(t, eng.TemplateCache())
 
 	_, err := eng.Services().Email(nil)
 	assert.EqualError(t, err, "no email service factory configured")
@@ -38,4 +40,22 @@ func TestBuilder(t *testing.T) {
 	svc, err := eng.Services().Webhook(nil)
 	assert.NoError(t, err)
 	assert.Equal(t, webhookSvc, svc)
+
+	// enable case tracing
+	eng = engine.NewBuilder().WithCaseTracing(true).Build()
+
+	assert.True(t, eng.CaseTracing())
+
+	// each engine has its own template cache which can be disabled
+	eng1 := engine.NewBuilder().WithTemplateCacheSize(10).Build()
+	eng2 := engine.NewBuilder().WithTemplateCacheSize(10).Build()
+
+	eng1.TemplateCache().Compile("Hi @contact", []string{"contact"})
+
+	assert.Equal(t, 1, eng1.TemplateCache().Len())
+	assert.Equal(t, 0, eng2.TemplateCache().Len())
+
+	eng = engine.NewBuilder().WithTemplateCacheSize(0).Build()
+
+	assert.Nil(t, eng.TemplateCache())
 }
@@ -240,11 +240,18 @@ func (s *session) tryToResume(sprint flows.Sprint, waitingRun flows.FlowRun, res
 		sprint.LogEvent(e)
 	}
 
-	// resumes are allowed to make state changes
+	// resumes are allowed to make state changes, and fail the session if they can't be applied
 	if err := resume.Apply(waitingRun, logEvent); err != nil {
 		return err
 	}
 
+	// some resumes move the run to another node, leaving the node we were waiting on without routing it
+	if withDestination, hasDestination := resume.(flows.ResumeWithDestination); hasDestination {
+		step.Leave("")
+
+		return s.continueUntilWait(sprint, waitingRun, withDestination.NodeUUID(), step, nil)
+	}
+
 	_, isTimeout := resume.(*resumes.WaitTimeoutResume)
 
 	destination, err := s.findResumeDestination(sprint, waitingRun, isTimeout)
@@ -252,6 +259,11 @@ func (s *session) tryToResume(sprint flows.Sprint, waitingRun flows.FlowRun, res
 		return err
 	}
 
+	// router may have decided to wait again on the same node
+	if s.status == flows.SessionStatusWaiting {
+		return nil
+	}
+
 	// off to the races again...
 	return s.continueUntilWait(sprint, waitingRun, destination, step, nil)
 }
@@ -333,6 +345,8 @@ func (s *session) continueUntilWait(sprint flows.Sprint, currentRun flows.FlowRu
 
 					if destination, err = s.findResumeDestination(sprint, currentRun, false); err != nil {
 						failure(sprint, currentRun, step, errors.Wrapf(err, "can't resume run as node no longer exists"))
+					} else if s.status == flows.SessionStatusWaiting {
+						return nil
 					}
 				} else {
 					// if we did error then that needs to bubble back up through the run hierarchy
@@ -341,9 +355,12 @@ func (s *session) continueUntilWait(sprint flows.Sprint, currentRun flows.FlowRu
 				}
 
 			} else {
-				// If we have no destination and no parent, then the whole session is done. A run error bubbles up the session status.
+				// If we have no destination and no parent, then the whole session is done. A run error or interruption
+				// bubbles up the session status.
 				if currentRun.Status() == flows.RunStatusFailed {
 					s.status = flows.SessionStatusFailed
+				} else if currentRun.Status() == flows.RunStatusInterrupted {
+					s.status = flows.SessionStatusInterrupted
 				} else {
 					s.status = flows.SessionStatusCompleted
 				}
@@ -425,18 +442,9 @@ func (s *session) visitNode(sprint flows.Sprint, run flows.FlowRun, node flows.N
 		wait = node.Router().Wait()
 	}
 
-	if wait != nil {
-
-		// waits have the option to skip themselves
-		activatedWait := wait.Begin(run, logEvent)
-		if activatedWait != nil {
-			// mark ouselves as waiting and hand back to
-			run.SetStatus(flows.RunStatusWaiting)
-			s.wait = activatedWait
-			s.status = flows.SessionStatusWaiting
-
-			return step, noDestination, nil
-		}
+	// waits have the option to skip themselves
+	if wait != nil && s.beginWait(run, wait, logEvent) {
+		return step, noDestination, nil
 	}
 
 	// use our node's router to determine where to go next
@@ -444,14 +452,39 @@ func (s *session) visitNode(sprint flows.Sprint, run flows.FlowRun, node flows.N
 	return step, destinationUUID, err
 }
 
+// begins the given wait, returning whether the wait was activated or skipped itself
+func (s *session) beginWait(run flows.FlowRun, wait flows.Wait, logEvent flows.EventCallback) bool {
+	activatedWait := wait.Begin(run, logEvent)
+	if activatedWait == nil {
+		return false
+	}
+
+	// mark ouselves as waiting and hand back to
+	run.SetStatus(flows.RunStatusWaiting)
+	s.wait = activatedWait
+	s.status = flows.SessionStatusWaiting
+	return true
+}
+
 // picks the exit to use on the given node
 func (s *session) pickNodeExit(sprint flows.Sprint, run flows.FlowRun, node flows.Node, step flows.Step, isTimeout bool, logEvent flows.EventCallback) (flows.NodeUUID, error) {
 	var exitUUID flows.ExitUUID
 	var err error
 
 	if node.Router() != nil {
+		retryingRouter, canRetry := node.Router().(flows.RetryingRouter)
+
 		if isTimeout {
 			exitUUID, err = node.Router().RouteTimeout(run, step, logEvent)
+		} else if canRetry {
+			// router may want to wait again on this node, and if the wait skips itself, it picks an exit instead
+			waitAgain := func() bool { return s.beginWait(run, node.Router().Wait(), logEvent) }
+
+			var retry bool
+			exitUUID, retry, err = retryingRouter.RouteOrRetry(run, step, waitAgain, logEvent)
+			if err == nil && retry {
+				return noDestination, nil
+			}
 		} else {
 			exitUUID, err = node.Router().Route(run, step, logEvent)
 		}
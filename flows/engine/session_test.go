@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nyaruka/gocommon/urns"
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/assets/static"
 	"github.com/nyaruka/goflow/envs"
@@ -241,6 +242,45 @@ func TestWaitTimeout(t *testing.T) {
 	require.Equal(t, "", result.Input)
 }
 
+func TestCallHangup(t *testing.T) {
+	assetsJSON, err := ioutil.ReadFile("testdata/dial_hangup.json")
+	require.NoError(t, err)
+
+	sa, err := test.CreateSessionAssets(assetsJSON, "")
+	require.NoError(t, err)
+
+	flow, err := sa.Flows().Get(assets.FlowUUID("b6a8e4a1-31ba-4fc5-9d28-3ef3d0b1a1f6"))
+	require.NoError(t, err)
+
+	env := envs.NewBuilder().Build()
+	contact := flows.NewEmptyContact(sa, "Bob", envs.NilLanguage, nil)
+	channel := assets.NewChannelReference("57f1078f-88aa-46f4-a59a-948a5739c03d", "Nexmo")
+	trigger := triggers.NewBuilder(env, flow.Reference(), contact).Manual().WithConnection(channel, urns.URN("tel:+12065551212")).Build()
+
+	session, _, err := test.NewEngine().NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	// child run should be waiting for the dial
+	assert.Equal(t, flows.SessionStatusWaiting, session.Status())
+	assert.Equal(t, 2, len(session.Runs()))
+	assert.Equal(t, flows.RunStatusActive, session.Runs()[0].Status())
+	assert.Equal(t, flows.RunStatusWaiting, session.Runs()[1].Status())
+
+	// hanging up interrupts both runs and the session
+	sprint, err := session.Resume(resumes.NewCallStatus(nil, nil, flows.ConnectionStatusHangup))
+	require.NoError(t, err)
+
+	assert.Equal(t, flows.SessionStatusInterrupted, session.Status())
+	assert.Equal(t, flows.RunStatusInterrupted, session.Runs()[0].Status())
+	assert.Equal(t, flows.RunStatusInterrupted, session.Runs()[1].Status())
+	assert.Equal(t, 1, len(sprint.Events()))
+	assert.Equal(t, "call_status_changed", sprint.Events()[0].Type())
+
+	// and it can't be resumed again
+	_, err = session.Resume(resumes.NewCallStatus(nil, nil, flows.ConnectionStatusTransferCompleted))
+	assert.EqualError(t, err, "only waiting sessions can be resumed")
+}
+
 func TestCurrentContext(t *testing.T) {
 	assetsJSON, err := ioutil.ReadFile("../../test/testdata/runner/subflow_loop_with_wait.json")
 	require.NoError(t, err)
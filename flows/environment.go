@@ -34,6 +34,8 @@ type assetLocationResolver struct {
 	locations assets.LocationHierarchy
 }
 
+var _ envs.PointLocationResolver = (*assetLocationResolver)(nil)
+
 // FindLocations returns locations with the matching name (case-insensitive), level and parent (optional)
 func (r *assetLocationResolver) FindLocations(name string, level envs.LocationLevel, parent *envs.Location) []*envs.Location {
 	return r.locations.FindByName(name, level, parent)
@@ -76,6 +78,16 @@ func (r *assetLocationResolver) FindLocationsFuzzy(text string, level envs.Locat
 	return []*envs.Location{}
 }
 
+// FindLocationByPoint returns the location with the given level whose boundary contains the given point, if the
+// location hierarchy has boundaries
+func (r *assetLocationResolver) FindLocationByPoint(lat, lng float64, level envs.LocationLevel) *envs.Location {
+	finder, ok := r.locations.(assets.LocationPointFinder)
+	if !ok {
+		return nil
+	}
+	return finder.FindByPoint(lat, lng, level)
+}
+
 func (r *assetLocationResolver) LookupLocation(path envs.LocationPath) *envs.Location {
 	return r.locations.FindByPath(path)
 }
@@ -21,18 +21,33 @@ func registerType(name string, initFunc func() flows.Event) {
 // base of all event types
 type baseEvent struct {
 	Type_      string         `json:"type" validate:"required"`
+	Version_   int            `json:"version,omitempty" validate:"omitempty,min=2"`
 	CreatedOn_ time.Time      `json:"created_on" validate:"required"`
 	StepUUID_  flows.StepUUID `json:"step_uuid,omitempty" validate:"omitempty,uuid4"`
 }
 
 // creates a new base event
 func newBaseEvent(typeName string) baseEvent {
-	return baseEvent{Type_: typeName, CreatedOn_: dates.Now()}
+	e := baseEvent{Type_: typeName, CreatedOn_: dates.Now()}
+
+	// version 1 is implied when version is omitted
+	if version := CurrentVersion(typeName); version > 1 {
+		e.Version_ = version
+	}
+	return e
 }
 
 // Type returns the type of this event
 func (e *baseEvent) Type() string { return e.Type_ }
 
+// Version returns the schema version of this event
+func (e *baseEvent) Version() int {
+	if e.Version_ == 0 {
+		return 1
+	}
+	return e.Version_
+}
+
 // CreatedOn returns the created on time of this event
 func (e *baseEvent) CreatedOn() time.Time { return e.CreatedOn_ }
 
@@ -46,16 +61,37 @@ func (e *baseEvent) SetStepUUID(stepUUID flows.StepUUID) { e.StepUUID_ = stepUUI
 // JSON Encoding / Decoding
 //------------------------------------------------------------------------------------------
 
-// ReadEvent reads a single event from the given JSON
+type versionedEnvelope struct {
+	utils.TypedEnvelope
+	Version int `json:"version"`
+}
+
+// ReadEvent reads a single event from the given JSON, upgrading it to the current version of its type if it was
+// written with an older version
 func ReadEvent(data json.RawMessage) (flows.Event, error) {
-	typeName, err := utils.ReadTypeFromJSON(data)
-	if err != nil {
+	header := &versionedEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, header); err != nil {
 		return nil, err
 	}
 
-	f := registeredTypes[typeName]
+	f := registeredTypes[header.Type]
 	if f == nil {
-		return nil, errors.Errorf("unknown type: '%s'", typeName)
+		return nil, errors.Errorf("unknown type: '%s'", header.Type)
+	}
+
+	version, current := header.Version, CurrentVersion(header.Type)
+	if version == 0 {
+		version = 1
+	}
+	if version > current {
+		return nil, errors.Errorf("version %d of %s events is newer than the supported version %d", version, header.Type, current)
+	}
+
+	if version < current {
+		var err error
+		if data, err = upgrade(header.Type, version, data); err != nil {
+			return nil, err
+		}
 	}
 
 	event := f()
@@ -86,6 +86,108 @@ func TestEventMarshaling(t *testing.T) {
 				"type": "airtime_transferred"
 			}`,
 		},
+		{
+			events.NewCasesEvaluated(
+				"yes please",
+				[]*events.CaseResult{
+					{
+						CaseUUID:  uuids.UUID("98503572-25bf-40ce-ad72-8836b6549a38"),
+						Type:      "has_number_gt",
+						Arguments: []string{"foo"},
+						Error:     "unable to convert \"foo\" to a number",
+					},
+					{
+						CaseUUID:  uuids.UUID("a51e5c8c-c891-401d-9c62-15fc37278c94"),
+						Type:      "has_pattern",
+						Arguments: []string{"(\\w+) please"},
+						Matched:   true,
+						Value:     "yes please",
+						Extra:     json.RawMessage(`{"0":"yes please","1":"yes"}`),
+					},
+				},
+			),
+			`{
+				"type": "cases_evaluated",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"operand": "yes please",
+				"results": [
+					{
+						"case_uuid": "98503572-25bf-40ce-ad72-8836b6549a38",
+						"type": "has_number_gt",
+						"arguments": ["foo"],
+						"matched": false,
+						"error": "unable to convert \"foo\" to a number"
+					},
+					{
+						"case_uuid": "a51e5c8c-c891-401d-9c62-15fc37278c94",
+						"type": "has_pattern",
+						"arguments": ["(\\w+) please"],
+						"matched": true,
+						"value": "yes please",
+						"extra": {"0": "yes please", "1": "yes"}
+					}
+				]
+			}`,
+		},
+		{
+			events.NewCallStatusChanged(flows.ConnectionStatusHangup),
+			`{
+				"type": "call_status_changed",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"status": "hangup"
+			}`,
+		},
+		{
+			events.NewDialEnded(flows.NewDial(flows.DialStatusAnswered, 10)),
+			`{
+				"type": "dial_ended",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"dial": {
+					"status": "answered",
+					"duration": 10
+				}
+			}`,
+		},
+		{
+			events.NewDialWait(urns.URN("tel:+593979123456"), 60, 7200),
+			`{
+				"type": "dial_wait",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"urn": "tel:+593979123456",
+				"dial_limit_seconds": 60,
+				"call_limit_seconds": 7200
+			}`,
+		},
+		{
+			events.NewFormReceived(flows.NewFormIn("9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a", nil, map[string]json.RawMessage{"age": json.RawMessage(`32`)})),
+			`{
+				"type": "form_received",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"form": {
+					"uuid": "9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a",
+					"answers": {"age": 32}
+				}
+			}`,
+		},
+		{
+			events.NewFormWait([]*flows.FormField{flows.NewFormField("age", flows.FormFieldTypeNumber, "Age")}, nil),
+			`{
+				"type": "form_wait",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"fields": [{"key": "age", "type": "number", "result_name": "Age"}]
+			}`,
+		},
+		{
+			events.NewRunJumped(session.Runs()[0], "11a772f3-3ca2-4429-8b33-20fdcfc2b69e", "bob@nyaruka.com", "Contact was stuck"),
+			`{
+				"type": "run_jumped",
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"run_uuid": "e7187099-7d38-4f60-955c-325957214c42",
+				"node_uuid": "11a772f3-3ca2-4429-8b33-20fdcfc2b69e",
+				"user": "bob@nyaruka.com",
+				"reason": "Contact was stuck"
+			}`,
+		},
 		{
 			events.NewBroadcastCreated(
 				map[envs.Language]*events.BroadcastTranslation{
@@ -168,6 +270,7 @@ func TestEventMarshaling(t *testing.T) {
 			events.NewContactFieldChanged(
 				gender,
 				flows.NewValue(types.NewXText("male"), nil, nil, "", "", ""),
+				nil,
 			),
 			`{
 				"created_on": "2018-10-18T14:20:30.000123456Z",
@@ -181,10 +284,32 @@ func TestEventMarshaling(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			events.NewContactFieldChanged(
+				gender,
+				flows.NewValue(types.NewXText("female"), nil, nil, "", "", ""),
+				flows.NewValue(types.NewXText("male"), nil, nil, "", "", ""),
+			),
+			`{
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"field": {
+					"key": "gender",
+					"name": "Gender"
+				},
+				"previous": {
+					"text": "male"
+				},
+				"type": "contact_field_changed",
+				"value": {
+					"text": "female"
+				}
+			}`,
+		},
 		{
 			events.NewContactFieldChanged(
 				gender,
 				nil, // value being cleared
+				flows.NewValue(types.NewXText("male"), nil, nil, "", "", ""),
 			),
 			`{
 				"created_on": "2018-10-18T14:20:30.000123456Z",
@@ -192,6 +317,9 @@ func TestEventMarshaling(t *testing.T) {
 					"key": "gender",
 					"name": "Gender"
 				},
+				"previous": {
+					"text": "male"
+				},
 				"type": "contact_field_changed",
 				"value": null
 			}`,
@@ -244,6 +372,24 @@ func TestEventMarshaling(t *testing.T) {
 				"type": "contact_language_changed"
 			}`,
 		},
+		{
+			events.NewContactMerged(
+				flows.NewContactReference("0e06f977-cbb7-475f-9d0b-a0c4aaec7f6a", "Robert"),
+				[]urns.URN{"tel:+12065551212"},
+				[]string{"gender"},
+				[]*flows.Group{session.Assets().Groups().Get("b7cf0d83-f1c9-411c-96fd-c511a4cfa86d")},
+				true,
+			),
+			`{
+				"created_on": "2018-10-18T14:20:30.000123456Z",
+				"fields_changed": ["gender"],
+				"groups_added": [{"uuid": "b7cf0d83-f1c9-411c-96fd-c511a4cfa86d", "name": "Testers"}],
+				"language_changed": true,
+				"merged_contact": {"uuid": "0e06f977-cbb7-475f-9d0b-a0c4aaec7f6a", "name": "Robert"},
+				"type": "contact_merged",
+				"urns_added": ["tel:+12065551212"]
+			}`,
+		},
 		{
 			events.NewContactRefreshed(session.Contact()),
 			`{
@@ -254,6 +400,7 @@ func TestEventMarshaling(t *testing.T) {
 							"text": "AACC55"
 						},
 						"age": {
+							"changed_on": "2018-10-18T14:20:30.000123456Z",
 							"number": 23,
 							"text": "23"
 						},
@@ -540,6 +687,11 @@ func TestEventMarshaling(t *testing.T) {
 		// try to read event back
 		_, err = events.ReadEvent(eventJSON)
 		assert.NoError(t, err)
+
+		// and check it validates against the schema for its type
+		schema, err := events.Schema(tc.event.Type())
+		require.NoError(t, err)
+		assert.NoError(t, schema.Validate(eventJSON), "schema validation failed for %s event", tc.event.Type())
 	}
 }
 
@@ -562,6 +714,40 @@ func TestReadEvent(t *testing.T) {
 
 }
 
+func TestEventSchema(t *testing.T) {
+	_, err := events.Schema("do_the_foo")
+	assert.EqualError(t, err, "unknown type: 'do_the_foo'")
+
+	schema, err := events.Schema(events.TypeDialEnded)
+	require.NoError(t, err)
+
+	marshaled, err := jsonx.Marshal(schema)
+	require.NoError(t, err)
+	test.AssertEqualJSON(t, []byte(`{
+		"type": "object",
+		"properties": {
+			"type": {"type": "string", "enum": ["dial_ended"]},
+			"version": {"type": "integer"},
+			"created_on": {"type": "string", "format": "date-time"},
+			"step_uuid": {"type": "string"},
+			"dial": {
+				"type": "object",
+				"nullable": true,
+				"properties": {
+					"status": {"type": "string"},
+					"duration": {"type": "integer"}
+				},
+				"required": ["status"]
+			}
+		},
+		"required": ["type", "created_on", "dial"]
+	}`), marshaled, "schema mismatch")
+
+	assert.NoError(t, schema.Validate([]byte(`{"type": "dial_ended", "created_on": "2006-01-02T15:04:05Z", "dial": {"status": "busy", "duration": 0}}`)))
+	assert.EqualError(t, schema.Validate([]byte(`{"type": "dial_started", "created_on": "2006-01-02T15:04:05Z", "dial": {"status": "busy"}}`)), "$.type: value is not one of the allowed values")
+	assert.EqualError(t, schema.Validate([]byte(`{"type": "dial_ended", "created_on": "2006-01-02T15:04:05Z", "dial": {"duration": 5}}`)), "$.dial: missing required property 'status'")
+}
+
 func TestWebhookCalledEventTrimming(t *testing.T) {
 	defer httpx.SetRequestor(httpx.DefaultRequestor)
 
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeCallStatusChanged, func() flows.Event { return &CallStatusChangedEvent{} })
+}
+
+// TypeCallStatusChanged is the type of our call status changed event
+const TypeCallStatusChanged string = "call_status_changed"
+
+// CallStatusChangedEvent events are created when a voice session is resumed because the status of the call changed,
+// i.e. the call was hung up or a transfer completed.
+//
+//   {
+//     "type": "call_status_changed",
+//     "created_on": "2019-01-02T15:04:05Z",
+//     "status": "hangup"
+//   }
+//
+// @event call_status_changed
+type CallStatusChangedEvent struct {
+	baseEvent
+
+	Status flows.ConnectionStatus `json:"status" validate:"required"`
+}
+
+// NewCallStatusChanged returns a new call status changed event
+func NewCallStatusChanged(status flows.ConnectionStatus) *CallStatusChangedEvent {
+	return &CallStatusChangedEvent{
+		baseEvent: newBaseEvent(TypeCallStatusChanged),
+		Status:    status,
+	}
+}
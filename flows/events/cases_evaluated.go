@@ -0,0 +1,71 @@
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/utils/uuids"
+)
+
+func init() {
+	registerType(TypeCasesEvaluated, func() flows.Event { return &CasesEvaluatedEvent{} })
+}
+
+// TypeCasesEvaluated is the type of our cases evaluated event
+const TypeCasesEvaluated string = "cases_evaluated"
+
+// CaseResult is the result of evaluating a single case of a switch router
+type CaseResult struct {
+	CaseUUID  uuids.UUID      `json:"case_uuid" validate:"required,uuid4"`
+	Type      string          `json:"type" validate:"required"`
+	Arguments []string        `json:"arguments,omitempty"`
+	Matched   bool            `json:"matched"`
+	Value     string          `json:"value,omitempty"`
+	Extra     json.RawMessage `json:"extra,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// CasesEvaluatedEvent events are created when a switch router has evaluated its cases, if case tracing is enabled
+// on the engine. They contain the evaluated operand and the result of each case that was tested, in order, which
+// will be all cases if none matched.
+//
+//   {
+//     "type": "cases_evaluated",
+//     "created_on": "2006-01-02T15:04:05Z",
+//     "operand": "yes please",
+//     "results": [
+//       {
+//         "case_uuid": "98503572-25bf-40ce-ad72-8836b6549a38",
+//         "type": "has_number_gt",
+//         "arguments": ["foo"],
+//         "matched": false,
+//         "error": "unable to convert \"foo\" to a number"
+//       },
+//       {
+//         "case_uuid": "a51e5c8c-c891-401d-9c62-15fc37278c94",
+//         "type": "has_any_word",
+//         "arguments": ["yes"],
+//         "matched": true,
+//         "value": "yes"
+//       }
+//     ]
+//   }
+//
+// @event cases_evaluated
+type CasesEvaluatedEvent struct {
+	baseEvent
+
+	Operand string        `json:"operand"`
+	Results []*CaseResult `json:"results" validate:"dive"`
+}
+
+// NewCasesEvaluated returns a new cases evaluated event
+func NewCasesEvaluated(operand string, results []*CaseResult) *CasesEvaluatedEvent {
+	return &CasesEvaluatedEvent{
+		baseEvent: newBaseEvent(TypeCasesEvaluated),
+		Operand:   operand,
+		Results:   results,
+	}
+}
+
+var _ flows.Event = (*CasesEvaluatedEvent)(nil)
@@ -13,28 +13,32 @@ func init() {
 const TypeContactFieldChanged string = "contact_field_changed"
 
 // ContactFieldChangedEvent events are created when a custom field value of the contact has been changed.
-// A null values indicates that the field value has been cleared.
+// A null values indicates that the field value has been cleared. If the field had a value before it was changed,
+// that is included as the previous value.
 //
 //   {
 //     "type": "contact_field_changed",
 //     "created_on": "2006-01-02T15:04:05Z",
 //     "field": {"key": "gender", "name": "Gender"},
-//     "value": {"text": "Male"}
+//     "value": {"text": "Male"},
+//     "previous": {"text": "Female"}
 //   }
 //
 // @event contact_field_changed
 type ContactFieldChangedEvent struct {
 	baseEvent
 
-	Field *assets.FieldReference `json:"field" validate:"required"`
-	Value *flows.Value           `json:"value"`
+	Field    *assets.FieldReference `json:"field" validate:"required"`
+	Value    *flows.Value           `json:"value"`
+	Previous *flows.Value           `json:"previous,omitempty"`
 }
 
 // NewContactFieldChanged returns a new save to contact event
-func NewContactFieldChanged(field *flows.Field, value *flows.Value) *ContactFieldChangedEvent {
+func NewContactFieldChanged(field *flows.Field, value *flows.Value, previous *flows.Value) *ContactFieldChangedEvent {
 	return &ContactFieldChangedEvent{
 		baseEvent: newBaseEvent(TypeContactFieldChanged),
 		Field:     field.Reference(),
 		Value:     value,
+		Previous:  previous,
 	}
 }
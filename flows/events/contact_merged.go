@@ -0,0 +1,50 @@
+package events
+
+import (
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeContactMerged, func() flows.Event { return &ContactMergedEvent{} })
+}
+
+// TypeContactMerged is the type of our contact merged event
+const TypeContactMerged string = "contact_merged"
+
+// ContactMergedEvent events are created when another contact has been merged into the contact. It summarizes what
+// changed, and is logged after the individual change events.
+//
+//   {
+//     "type": "contact_merged",
+//     "created_on": "2006-01-02T15:04:05Z",
+//     "merged_contact": {"uuid": "0e06f977-cbb7-475f-9d0b-a0c4aaec7f6a", "name": "Bob"},
+//     "urns_added": ["tel:+12065551212"],
+//     "fields_changed": ["age"],
+//     "groups_added": [{"uuid": "b7cf0d83-f1c9-411c-96fd-c511a4cfa86d", "name": "Reporters"}],
+//     "language_changed": true
+//   }
+//
+// @event contact_merged
+type ContactMergedEvent struct {
+	baseEvent
+
+	MergedContact   *flows.ContactReference  `json:"merged_contact" validate:"required,dive"`
+	URNsAdded       []urns.URN               `json:"urns_added,omitempty"`
+	FieldsChanged   []string                 `json:"fields_changed,omitempty"`
+	GroupsAdded     []*assets.GroupReference `json:"groups_added,omitempty" validate:"omitempty,dive"`
+	LanguageChanged bool                     `json:"language_changed,omitempty"`
+}
+
+// NewContactMerged returns a new contact merged event
+func NewContactMerged(merged *flows.ContactReference, urnsAdded []urns.URN, fieldsChanged []string, groupsAdded []*flows.Group, languageChanged bool) *ContactMergedEvent {
+	return &ContactMergedEvent{
+		baseEvent:       newBaseEvent(TypeContactMerged),
+		MergedContact:   merged,
+		URNsAdded:       urnsAdded,
+		FieldsChanged:   fieldsChanged,
+		GroupsAdded:     groupsToReferences(groupsAdded),
+		LanguageChanged: languageChanged,
+	}
+}
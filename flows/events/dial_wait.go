@@ -0,0 +1,43 @@
+package events
+
+import (
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeDialWait, func() flows.Event { return &DialWaitEvent{} })
+}
+
+// TypeDialWait is the type of our dial wait event
+const TypeDialWait string = "dial_wait"
+
+// DialWaitEvent events are created when a voice flow pauses waiting for the caller to be connected to
+// another number. The caller should dial the URN and resume the flow with the outcome.
+//
+//   {
+//     "type": "dial_wait",
+//     "created_on": "2019-01-02T15:04:05Z",
+//     "urn": "tel:+593979123456",
+//     "dial_limit_seconds": 60,
+//     "call_limit_seconds": 7200
+//   }
+//
+// @event dial_wait
+type DialWaitEvent struct {
+	baseEvent
+
+	URN              urns.URN `json:"urn" validate:"required,urn"`
+	DialLimitSeconds int      `json:"dial_limit_seconds"`
+	CallLimitSeconds int      `json:"call_limit_seconds"`
+}
+
+// NewDialWait returns a new dial wait with the passed in URN
+func NewDialWait(urn urns.URN, dialLimitSeconds int, callLimitSeconds int) *DialWaitEvent {
+	return &DialWaitEvent{
+		baseEvent:        newBaseEvent(TypeDialWait),
+		URN:              urn,
+		DialLimitSeconds: dialLimitSeconds,
+		CallLimitSeconds: callLimitSeconds,
+	}
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeFormReceived, func() flows.Event { return &FormReceivedEvent{} })
+}
+
+// TypeFormReceived is a constant for incoming form submissions
+const TypeFormReceived string = "form_received"
+
+// FormReceivedEvent events are created when a session is resumed with a form submitted by the contact.
+//
+//   {
+//     "type": "form_received",
+//     "created_on": "2006-01-02T15:04:05Z",
+//     "form": {
+//       "uuid": "9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a",
+//       "channel": {"uuid": "61602f3e-f603-4c70-8a8f-c477505bf4bf", "name": "WhatsApp"},
+//       "answers": {"name": "Bob", "age": 32, "subscribe": true}
+//     }
+//   }
+//
+// @event form_received
+type FormReceivedEvent struct {
+	baseEvent
+
+	Form *flows.FormIn `json:"form" validate:"required,dive"`
+}
+
+// NewFormReceived creates a new form received event
+func NewFormReceived(form *flows.FormIn) *FormReceivedEvent {
+	return &FormReceivedEvent{
+		baseEvent: newBaseEvent(TypeFormReceived),
+		Form:      form,
+	}
+}
+
+var _ flows.Event = (*FormReceivedEvent)(nil)
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeFormWait, func() flows.Event { return &FormWaitEvent{} })
+}
+
+// TypeFormWait is the type of our form wait event
+const TypeFormWait string = "form_wait"
+
+// FormWaitEvent events are created when a flow pauses waiting for the contact to submit a form. The fields are those
+// which the flow expects to be answered. If a timeout is set, then the caller should resume the flow after the number
+// of seconds in the timeout to resume it.
+//
+//   {
+//     "type": "form_wait",
+//     "created_on": "2019-01-02T15:04:05Z",
+//     "fields": [
+//       {"key": "name", "type": "text", "result_name": "Name"},
+//       {"key": "age", "type": "number", "result_name": "Age"}
+//     ],
+//     "timeout_seconds": 300
+//   }
+//
+// @event form_wait
+type FormWaitEvent struct {
+	baseEvent
+
+	Fields         []*flows.FormField `json:"fields" validate:"required,min=1,dive"`
+	TimeoutSeconds *int               `json:"timeout_seconds,omitempty"`
+}
+
+// NewFormWait returns a new form wait event
+func NewFormWait(fields []*flows.FormField, timeoutSeconds *int) *FormWaitEvent {
+	return &FormWaitEvent{
+		baseEvent:      newBaseEvent(TypeFormWait),
+		Fields:         fields,
+		TimeoutSeconds: timeoutSeconds,
+	}
+}
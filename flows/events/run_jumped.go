@@ -0,0 +1,47 @@
+package events
+
+import (
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeRunJumped, func() flows.Event { return &RunJumpedEvent{} })
+}
+
+// TypeRunJumped is the type of our run jumped event
+const TypeRunJumped string = "run_jumped"
+
+// RunJumpedEvent events are created when a waiting run is moved to another node in its flow by a jump resume,
+// recording who moved it and why.
+//
+//   {
+//     "type": "run_jumped",
+//     "created_on": "2006-01-02T15:04:05Z",
+//     "run_uuid": "0e06f977-cbb7-475f-9d0b-a0c4aaec7f6a",
+//     "node_uuid": "f5bb9b7a-7b5e-45c3-8f0e-61b4e95edf03",
+//     "user": "bob@nyaruka.com",
+//     "reason": "Contact was stuck on the age question"
+//   }
+//
+// @event run_jumped
+type RunJumpedEvent struct {
+	baseEvent
+
+	RunUUID  flows.RunUUID  `json:"run_uuid"  validate:"required,uuid4"`
+	NodeUUID flows.NodeUUID `json:"node_uuid" validate:"required,uuid4"`
+	User     string         `json:"user"      validate:"required"`
+	Reason   string         `json:"reason,omitempty"`
+}
+
+// NewRunJumped creates a new run jumped event
+func NewRunJumped(run flows.FlowRun, nodeUUID flows.NodeUUID, user, reason string) *RunJumpedEvent {
+	return &RunJumpedEvent{
+		baseEvent: newBaseEvent(TypeRunJumped),
+		RunUUID:   run.UUID(),
+		NodeUUID:  nodeUUID,
+		User:      user,
+		Reason:    reason,
+	}
+}
+
+var _ flows.Event = (*RunJumpedEvent)(nil)
@@ -0,0 +1,96 @@
+package events
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+// UpgradeFunc is a function that can upgrade an event from the previous version of its type
+type UpgradeFunc func(map[string]interface{}) (map[string]interface{}, error)
+
+var registeredUpgrades = map[string]map[int]UpgradeFunc{}
+
+// registers an upgrade which takes events of the given type to the given version
+func registerUpgrade(typeName string, version int, fn UpgradeFunc) {
+	if registeredUpgrades[typeName] == nil {
+		registeredUpgrades[typeName] = make(map[int]UpgradeFunc)
+	}
+	registeredUpgrades[typeName][version] = fn
+}
+
+// CurrentVersion returns the current schema version of events of the given type. Types without any upgrades are
+// at version 1.
+func CurrentVersion(typeName string) int {
+	current := 1
+	for version := range registeredUpgrades[typeName] {
+		if version > current {
+			current = version
+		}
+	}
+	return current
+}
+
+// upgrades the given event JSON from the given version to the current version of its type
+func upgrade(typeName string, from int, data json.RawMessage) (json.RawMessage, error) {
+	versions := make([]int, 0)
+	for v := range registeredUpgrades[typeName] {
+		if v > from {
+			versions = append(versions, v)
+		}
+	}
+
+	// we're already at the current version of this type
+	if len(versions) == 0 {
+		return data, nil
+	}
+
+	// sorted by earliest first
+	sort.Ints(versions)
+
+	g, err := jsonx.DecodeGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+
+	upgraded, _ := g.(map[string]interface{})
+	if upgraded == nil {
+		return nil, errors.New("event isn't an object")
+	}
+
+	for _, version := range versions {
+		upgraded, err = registeredUpgrades[typeName][version](upgraded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to upgrade %s event to version %d", typeName, version)
+		}
+
+		upgraded["version"] = version
+	}
+
+	return jsonx.Marshal(upgraded)
+}
+
+// Schema generates a JSON schema for events of the given type which downstream consumers can use to validate events
+func Schema(typeName string) (*jsonx.Schema, error) {
+	f := registeredTypes[typeName]
+	if f == nil {
+		return nil, errors.Errorf("unknown type: '%s'", typeName)
+	}
+
+	schema := jsonx.SchemaFor(reflect.TypeOf(f()).Elem())
+
+	// the type property can only be this type
+	typeJSON, _ := jsonx.Marshal(typeName)
+	schema.Properties["type"].Enum = []json.RawMessage{typeJSON}
+
+	// round trip through JSON so that the schema is ready to validate with
+	schemaJSON, err := jsonx.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return jsonx.ReadSchema(schemaJSON)
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventUpgrades(t *testing.T) {
+	defer delete(registeredUpgrades, TypeContactNameChanged)
+
+	assert.Equal(t, 1, CurrentVersion(TypeContactNameChanged))
+
+	// pretend that version 2 renamed name to full_name and version 3 renamed it back to name
+	registerUpgrade(TypeContactNameChanged, 3, func(e map[string]interface{}) (map[string]interface{}, error) {
+		e["name"] = e["full_name"]
+		delete(e, "full_name")
+		return e, nil
+	})
+	registerUpgrade(TypeContactNameChanged, 2, func(e map[string]interface{}) (map[string]interface{}, error) {
+		e["full_name"] = e["name"]
+		delete(e, "name")
+		return e, nil
+	})
+
+	assert.Equal(t, 3, CurrentVersion(TypeContactNameChanged))
+
+	// new events are created with the current version
+	marshaled, err := jsonx.Marshal(NewContactNameChanged("Bob"))
+	require.NoError(t, err)
+	assert.Contains(t, string(marshaled), `"version":3`)
+
+	// events without a version are version 1 and are upgraded through each version
+	event, err := ReadEvent([]byte(`{"type": "contact_name_changed", "created_on": "2006-01-02T15:04:05Z", "name": "Bob Smith"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "Bob Smith", event.(*ContactNameChangedEvent).Name)
+	assert.Equal(t, 3, event.(*ContactNameChangedEvent).Version())
+
+	// events from an intermediate version only go through later upgrades
+	event, err = ReadEvent([]byte(`{"type": "contact_name_changed", "version": 2, "created_on": "2006-01-02T15:04:05Z", "full_name": "Jim Smith"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "Jim Smith", event.(*ContactNameChangedEvent).Name)
+
+	// events from a newer version than we support are an error
+	_, err = ReadEvent([]byte(`{"type": "contact_name_changed", "version": 4, "created_on": "2006-01-02T15:04:05Z", "name": "Bob"}`))
+	assert.EqualError(t, err, "version 4 of contact_name_changed events is newer than the supported version 3")
+
+	// as are upgrades which fail
+	registerUpgrade(TypeContactNameChanged, 2, func(e map[string]interface{}) (map[string]interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	_, err = ReadEvent([]byte(`{"type": "contact_name_changed", "created_on": "2006-01-02T15:04:05Z", "name": "Bob"}`))
+	assert.EqualError(t, err, "unable to upgrade contact_name_changed event to version 2: boom")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/envs"
@@ -277,12 +278,17 @@ func (f FieldValues) Parse(env envs.Environment, fields *FieldAssets, field *Fie
 
 // Context returns the properties available in expressions
 func (f FieldValues) Context(env envs.Environment) map[string]types.XValue {
+	return f.context(env, nil)
+}
+
+// gets the properties available in expressions, with each field's last change taken from the given history
+func (f FieldValues) context(env envs.Environment, history FieldHistory) map[string]types.XValue {
 	entries := make(map[string]types.XValue, len(f)+1)
 	lines := make([]string, 0, len(f))
 
 	for k, v := range f {
 		val := v.ToXValue(env)
-		entries[string(k)] = val
+		entries[string(k)] = fieldContext(env, val, history[k])
 
 		if !utils.IsNil(val) {
 			lines = append(lines, fmt.Sprintf("%s: %s", v.field.Name(), types.Render(val)))
@@ -295,6 +301,33 @@ func (f FieldValues) Context(env envs.Environment) map[string]types.XValue {
 	return entries
 }
 
+// the value of a field in expressions, which renders as the typed value but also has the last change of the field
+//
+//   __default__:any -> the value of the field
+//   previous:any -> the value of the field before it was last changed
+//   changed_on:datetime -> when the field was last changed
+//
+// @context field
+func fieldContext(env envs.Environment, val types.XValue, change *FieldChange) types.XValue {
+	var previous, changedOn types.XValue
+	if change != nil {
+		if change.previous != nil {
+			previous = NewFieldValue(change.field, change.previous).ToXValue(env)
+		}
+		changedOn = types.NewXDateTime(change.changedOn)
+	}
+
+	context := types.NewXObject(map[string]types.XValue{
+		"__default__": val,
+		"previous":    previous,
+		"changed_on":  changedOn,
+	})
+
+	// the history isn't part of the field value so isn't included in JSON
+	context.SetMarshalAsDefault(true)
+	return context
+}
+
 func (f FieldValues) getFirstLocationValue(env envs.Environment, fields *FieldAssets, valueType assets.FieldType) *envs.Location {
 	// do we have a field of this type?
 	field := fields.FirstOfType(valueType)
@@ -310,6 +343,36 @@ func (f FieldValues) getFirstLocationValue(env envs.Environment, fields *FieldAs
 	return env.LocationResolver().LookupLocation(envs.LocationPath(value.(types.XText).Native()))
 }
 
+// FieldChange is the last change of a field, i.e. the value it had before and when it was changed
+type FieldChange struct {
+	field     *Field
+	previous  *Value
+	changedOn time.Time
+}
+
+// NewFieldChange creates a new field change
+func NewFieldChange(field *Field, previous *Value, changedOn time.Time) *FieldChange {
+	return &FieldChange{field: field, previous: previous, changedOn: changedOn}
+}
+
+// Previous returns the value the field had before it was changed (optional)
+func (c *FieldChange) Previous() *Value { return c.previous }
+
+// ChangedOn returns when the field was changed
+func (c *FieldChange) ChangedOn() time.Time { return c.changedOn }
+
+// FieldHistory is the last change of each field which has been changed
+type FieldHistory map[string]*FieldChange
+
+// clones this field history
+func (h FieldHistory) clone() FieldHistory {
+	clone := make(FieldHistory, len(h))
+	for k, v := range h {
+		clone[k] = v
+	}
+	return clone
+}
+
 // FieldAssets provides access to all field assets
 type FieldAssets struct {
 	all   []*Field
@@ -2,12 +2,14 @@ package flows_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/jsonx"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,14 +42,80 @@ func TestFieldValues(t *testing.T) {
 	test.AssertXEqual(t, types.NewXText("Male"), genderVal.ToXValue(env))
 	assert.Nil(t, ageVal.ToXValue(env)) // doesn't have a value in the right type
 
-	test.AssertXEqual(t, types.NewXObject(map[string]types.XValue{
-		"__default__":      types.NewXText("Gender: Male"),
-		"activation_token": nil,
-		"age":              nil,
-		"gender":           types.NewXText("Male"),
-		"join_date":        nil,
-		"not_set":          nil,
-	}), flows.Context(env, fieldVals))
+	context := flows.Context(env, fieldVals).(*types.XObject)
+	assert.Equal(t, []string{"activation_token", "age", "gender", "join_date", "not_set"}, context.Properties())
+	test.AssertXEqual(t, types.NewXText("Gender: Male"), context.Default())
+
+	// each field is an object which renders as its value
+	genderCtx, _ := context.Get("gender")
+	assert.Equal(t, "Male", types.Render(genderCtx))
+	assertFieldJSON(t, `"Male"`, genderCtx)
+	ageCtx, _ := context.Get("age")
+	assert.Equal(t, "", types.Render(ageCtx))
+	assertFieldJSON(t, `null`, ageCtx)
+	assertFieldHistory(t, nil, nil, genderCtx)
+}
+
+func TestFieldHistory(t *testing.T) {
+	session, _, err := test.CreateTestSession("http://localhost", envs.RedactionPolicyNone)
+	require.NoError(t, err)
+
+	env := session.Environment()
+	contact := session.Contact().Clone()
+	gender := session.Assets().Fields().Get("gender")
+
+	// changing a value records the previous value and when it was changed
+	changedOn := time.Date(2020, 7, 23, 10, 14, 0, 0, time.UTC)
+	contact.ChangeField(gender, flows.NewValue(types.NewXText("Female"), nil, nil, "", "", ""), changedOn)
+
+	assert.Equal(t, types.NewXText("Female"), contact.Fields().Get(gender).Text)
+	assert.Equal(t, types.NewXText("Male"), contact.FieldHistory()["gender"].Previous().Text)
+	assert.Equal(t, changedOn, contact.FieldHistory()["gender"].ChangedOn())
+
+	// which is available in expressions, but the value itself is unchanged
+	genderCtx := contact.FieldsContext(env)["gender"]
+	assert.Equal(t, "Female", types.Render(genderCtx))
+	assertFieldJSON(t, `"Female"`, genderCtx)
+	assertFieldHistory(t, types.NewXText("Male"), types.NewXDateTime(changedOn), genderCtx)
+
+	// clearing a value also records the previous value, but leaves no field value
+	clearedOn := time.Date(2020, 7, 24, 10, 14, 0, 0, time.UTC)
+	contact.ChangeField(gender, nil, clearedOn)
+
+	assert.Nil(t, contact.Fields().Get(gender))
+	assert.Nil(t, contact.Fields()["gender"])
+	assert.Equal(t, types.NewXText("Female"), contact.FieldHistory()["gender"].Previous().Text)
+	assert.Equal(t, clearedOn, contact.FieldHistory()["gender"].ChangedOn())
+
+	genderCtx = contact.FieldsContext(env)["gender"]
+	assert.Equal(t, "", types.Render(genderCtx))
+	assertFieldJSON(t, `null`, genderCtx)
+	assertFieldHistory(t, types.NewXText("Female"), types.NewXDateTime(clearedOn), genderCtx)
+
+	// history is written with the contact and read back
+	contactJSON, err := jsonx.Marshal(contact)
+	require.NoError(t, err)
+
+	contact, err = flows.ReadContact(session.Assets(), contactJSON, assets.PanicOnMissing)
+	require.NoError(t, err)
+
+	assert.Nil(t, contact.Fields()["gender"])
+	assert.Equal(t, types.NewXText("Female"), contact.FieldHistory()["gender"].Previous().Text)
+	assert.Equal(t, clearedOn, contact.FieldHistory()["gender"].ChangedOn())
+}
+
+func assertFieldJSON(t *testing.T, expected string, fieldCtx types.XValue) {
+	asJSON, xerr := types.ToXJSON(fieldCtx)
+	require.NoError(t, xerr)
+	assert.Equal(t, expected, asJSON.Native())
+}
+
+func assertFieldHistory(t *testing.T, previous, changedOn types.XValue, fieldCtx types.XValue) {
+	object := fieldCtx.(*types.XObject)
+	actualPrevious, _ := object.Get("previous")
+	actualChangedOn, _ := object.Get("changed_on")
+	test.AssertXEqual(t, previous, actualPrevious)
+	test.AssertXEqual(t, changedOn, actualChangedOn)
 }
 
 func TestValues(t *testing.T) {
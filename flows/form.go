@@ -0,0 +1,71 @@
+package flows
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/utils/uuids"
+
+	"github.com/pkg/errors"
+)
+
+// FormFieldType is the type of value expected for a form field
+type FormFieldType string
+
+// possible form field types
+const (
+	FormFieldTypeText     FormFieldType = "text"
+	FormFieldTypeNumber   FormFieldType = "number"
+	FormFieldTypeDatetime FormFieldType = "datetime"
+	FormFieldTypeBoolean  FormFieldType = "boolean"
+)
+
+// FormField is a field which a form wait expects to be answered, and the name of the result its answer is saved as
+type FormField struct {
+	Key        string        `json:"key"         validate:"required"`
+	Type       FormFieldType `json:"type"        validate:"required,eq=text|eq=number|eq=datetime|eq=boolean"`
+	ResultName string        `json:"result_name" validate:"required"`
+}
+
+// NewFormField creates a new form field
+func NewFormField(key string, type_ FormFieldType, resultName string) *FormField {
+	return &FormField{Key: key, Type: type_, ResultName: resultName}
+}
+
+// Parse parses the given JSON answer as the type of this field
+func (f *FormField) Parse(env envs.Environment, answer json.RawMessage) (types.XValue, error) {
+	value := types.JSONToXValue(answer)
+
+	var parsed types.XValue
+	var xerr types.XError
+
+	switch f.Type {
+	case FormFieldTypeNumber:
+		parsed, xerr = types.ToXNumber(env, value)
+	case FormFieldTypeDatetime:
+		parsed, xerr = types.ToXDateTime(env, value)
+	case FormFieldTypeBoolean:
+		parsed, xerr = types.ToXBoolean(value)
+	default:
+		parsed, xerr = types.ToXText(env, value)
+	}
+
+	if xerr != nil {
+		return nil, errors.Errorf("answer for form field '%s' is not a valid %s", f.Key, f.Type)
+	}
+	return parsed, nil
+}
+
+// FormIn is a structured form submission received from the contact, e.g. from a web form
+type FormIn struct {
+	UUID    uuids.UUID                 `json:"uuid"              validate:"required,uuid4"`
+	Channel *assets.ChannelReference   `json:"channel,omitempty" validate:"omitempty,dive"`
+	Answers map[string]json.RawMessage `json:"answers"`
+}
+
+// NewFormIn creates a new incoming form submission
+func NewFormIn(uuid uuids.UUID, channel *assets.ChannelReference, answers map[string]json.RawMessage) *FormIn {
+	return &FormIn{UUID: uuid, Channel: channel, Answers: answers}
+}
@@ -0,0 +1,122 @@
+package inputs
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+)
+
+func init() {
+	registerType(TypeForm, readFormInput)
+}
+
+// TypeForm is a constant for incoming form submissions
+const TypeForm string = "form"
+
+// FormInput is a form submission which can be used as input
+type FormInput struct {
+	baseInput
+
+	answers map[string]json.RawMessage
+}
+
+// NewForm creates a new user input based on a form submission
+func NewForm(assets flows.SessionAssets, form *flows.FormIn, createdOn time.Time) *FormInput {
+	// load the channel
+	var channel *flows.Channel
+	if form.Channel != nil {
+		channel = assets.Channels().Get(form.Channel.UUID)
+	}
+
+	return &FormInput{
+		baseInput: newBaseInput(TypeForm, flows.InputUUID(form.UUID), channel, createdOn),
+		answers:   form.Answers,
+	}
+}
+
+// Answers returns the JSON answers of this form submission keyed by field
+func (i *FormInput) Answers() map[string]json.RawMessage { return i.answers }
+
+// Context returns the properties available in expressions
+func (i *FormInput) Context(env envs.Environment) map[string]types.XValue {
+	answers := make(map[string]types.XValue, len(i.answers))
+	for key, answer := range i.answers {
+		answers[key] = types.JSONToXValue(answer)
+	}
+
+	return map[string]types.XValue{
+		"__default__": types.NewXText(i.format(env)),
+		"type":        types.NewXText(i.type_),
+		"uuid":        types.NewXText(string(i.uuid)),
+		"created_on":  types.NewXDateTime(i.createdOn),
+		"channel":     flows.Context(env, i.channel),
+		"urn":         nil,
+		"text":        types.NewXText(i.format(env)),
+		"attachments": types.NewXArray(),
+		"external_id": types.XTextEmpty,
+		"answers":     types.NewXObject(answers),
+	}
+}
+
+// formats the answers as key: value lines in key order
+func (i *FormInput) format(env envs.Environment) string {
+	keys := make([]string, 0, len(i.answers))
+	for key := range i.answers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for k, key := range keys {
+		lines[k] = key + ": " + types.Format(env, types.JSONToXValue(i.answers[key]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+var _ flows.Input = (*FormInput)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type formInputEnvelope struct {
+	baseInputEnvelope
+	Answers map[string]json.RawMessage `json:"answers"`
+}
+
+func readFormInput(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Input, error) {
+	e := &formInputEnvelope{}
+	err := utils.UnmarshalAndValidate(data, e)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &FormInput{
+		answers: e.Answers,
+	}
+
+	if err := i.unmarshal(sessionAssets, &e.baseInputEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// MarshalJSON marshals this form input into JSON
+func (i *FormInput) MarshalJSON() ([]byte, error) {
+	e := &formInputEnvelope{
+		Answers: i.answers,
+	}
+
+	i.marshal(&e.baseInputEnvelope)
+
+	return jsonx.Marshal(e)
+}
@@ -0,0 +1,62 @@
+package inputs_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/inputs"
+	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormInput(t *testing.T) {
+	session, _, err := test.CreateTestSession("", envs.RedactionPolicyNone)
+	require.NoError(t, err)
+
+	env := session.Environment()
+
+	channel := session.Assets().Channels().Get("57f1078f-88aa-46f4-a59a-948a5739c03d")
+
+	form := flows.NewFormIn(
+		"9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a",
+		assets.NewChannelReference("57f1078f-88aa-46f4-a59a-948a5739c03d", "Nexmo"),
+		map[string]json.RawMessage{"name": json.RawMessage(`"Bob"`), "age": json.RawMessage(`32`)},
+	)
+
+	input := inputs.NewForm(session.Assets(), form, time.Date(2018, 10, 22, 16, 12, 30, 123456, time.UTC))
+
+	assert.Equal(t, "form", input.Type())
+	assert.Equal(t, flows.InputUUID("9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a"), input.UUID())
+	assert.Equal(t, channel, input.Channel())
+	assert.Equal(t, time.Date(2018, 10, 22, 16, 12, 30, 123456, time.UTC), input.CreatedOn())
+
+	// check use in expressions
+	test.AssertXEqual(t, types.NewXObject(map[string]types.XValue{
+		"__default__": types.NewXText("age: 32\nname: Bob"),
+		"type":        types.NewXText("form"),
+		"uuid":        types.NewXText("9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a"),
+		"channel":     flows.Context(env, channel),
+		"created_on":  types.NewXDateTime(input.CreatedOn()),
+		"urn":         nil,
+		"text":        types.NewXText("age: 32\nname: Bob"),
+		"attachments": types.NewXArray(),
+		"external_id": types.XTextEmpty,
+		"answers": types.NewXObject(map[string]types.XValue{
+			"name": types.NewXText("Bob"),
+			"age":  types.NewXNumberFromInt(32),
+		}),
+	}), flows.Context(env, input))
+
+	// check marshaling to JSON
+	marshaled, err := jsonx.Marshal(input)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"form","uuid":"9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a","channel":{"uuid":"57f1078f-88aa-46f4-a59a-948a5739c03d","name":"My Android Phone"},"created_on":"2018-10-22T16:12:30.000123456Z","answers":{"age":32,"name":"Bob"}}`, string(marshaled))
+}
@@ -58,6 +58,7 @@ func NewMsg(assets flows.SessionAssets, msg *flows.MsgIn, createdOn time.Time) (
 //   text:text -> the text part of the input
 //   attachments:[]text -> any attachments on the input
 //   external_id:text -> the external ID of the input
+//   answers:any -> the answers if the input is a form submission
 //
 // @context input
 func (i *MsgInput) Context(env envs.Environment) map[string]types.XValue {
@@ -82,6 +83,7 @@ func (i *MsgInput) Context(env envs.Environment) map[string]types.XValue {
 		"text":        types.NewXText(i.text),
 		"attachments": types.NewXArray(attachments...),
 		"external_id": types.NewXText(i.externalID),
+		"answers":     nil,
 	}
 }
 
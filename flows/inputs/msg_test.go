@@ -57,6 +57,7 @@ func TestMsgInput(t *testing.T) {
 		"text":        types.NewXText("Hi there!"),
 		"attachments": types.NewXArray(types.NewXText("image/jpg:http://example.com/test.jpg"), types.NewXText("video/mp4:http://example.com/test.mp4")),
 		"external_id": types.NewXText("ext12345"),
+		"answers":     nil,
 	}), flows.Context(env, input))
 
 	// check marshaling to JSON
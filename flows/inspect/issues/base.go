@@ -4,10 +4,11 @@ import (
 	"sort"
 
 	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/flows"
 )
 
-type reportFunc func(flows.SessionAssets, flows.Flow, []flows.ExtractedTemplate, []flows.ExtractedReference, func(flows.Issue))
+type reportFunc func(flows.SessionAssets, flows.Flow, []flows.ExtractedTemplate, []flows.ExtractedReference, *tools.Schema, func(flows.Issue))
 
 var RegisteredTypes = map[string]reportFunc{}
 
@@ -51,15 +52,31 @@ func (p *baseIssue) Language() envs.Language { return p.Language_ }
 // Description returns the description of the issue
 func (p *baseIssue) Description() string { return p.Description_ }
 
-// Check returns all issues in the given flow
-func Check(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference) []flows.Issue {
+// type checks the given templates against the given schema if there is one
+func checkTemplates(tpls []flows.ExtractedTemplate, schema *tools.Schema, callback func(flows.ExtractedTemplate, *tools.Diagnostic)) {
+	if schema == nil {
+		return
+	}
+
+	for _, t := range tpls {
+		// expressions which can't be parsed are skipped
+		diagnostics, _ := tools.CheckTemplate(t.Template, flows.RunContextTopLevels, schema)
+
+		for _, d := range diagnostics {
+			callback(t, d)
+		}
+	}
+}
+
+// Check returns all issues in the given flow. Templates are only type checked if a schema is provided.
+func Check(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema) []flows.Issue {
 	issues := make([]flows.Issue, 0)
 	report := func(i flows.Issue) {
 		issues = append(issues, i)
 	}
 
 	for _, fn := range RegisteredTypes {
-		fn(sa, flow, tpls, refs, report)
+		fn(sa, flow, tpls, refs, schema, report)
 	}
 
 	// sort issues by node order
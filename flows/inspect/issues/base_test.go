@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/flows/definition"
 	"github.com/nyaruka/goflow/flows/inspect/issues"
@@ -22,12 +23,20 @@ func TestIssueTypes(t *testing.T) {
 	assets, err := test.LoadSessionAssets(env, "testdata/_assets.json")
 	require.NoError(t, err)
 
+	completionJSON, err := ioutil.ReadFile("../../../docs/en_US/completion.json")
+	require.NoError(t, err)
+	functionsJSON, err := ioutil.ReadFile("../../../docs/en_US/functions.json")
+	require.NoError(t, err)
+
+	schema, err := tools.ReadSchema(completionJSON, functionsJSON)
+	require.NoError(t, err)
+
 	for typeName := range issues.RegisteredTypes {
-		testIssueType(t, assets, typeName)
+		testIssueType(t, assets, schema, typeName)
 	}
 }
 
-func testIssueType(t *testing.T, sa flows.SessionAssets, typeName string) {
+func testIssueType(t *testing.T, sa flows.SessionAssets, schema *tools.Schema, typeName string) {
 	testPath := fmt.Sprintf("testdata/%s.json", typeName)
 	testFile, err := ioutil.ReadFile(testPath)
 	require.NoError(t, err)
@@ -55,7 +64,7 @@ func testIssueType(t *testing.T, sa flows.SessionAssets, typeName string) {
 			sessionAssets = sa
 		}
 
-		info := flow.Inspect(sessionAssets)
+		info := flow.Inspect(sessionAssets, schema)
 		issuesJSON, _ := jsonx.Marshal(info.Issues)
 
 		// clone test case and populate with actual values
@@ -111,7 +120,7 @@ func TestIssues(t *testing.T) {
 	}`), nil)
 	require.NoError(t, err)
 
-	info := flow.Inspect(sa)
+	info := flow.Inspect(sa, nil)
 
 	assert.Equal(t, 1, len(info.Issues))
 	assert.Equal(t, issues.TypeMissingDependency, info.Issues[0].Type())
@@ -0,0 +1,53 @@
+package issues
+
+import (
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeInvalidFunctionCall, InvalidFunctionCallCheck)
+}
+
+// TypeInvalidFunctionCall is our type for a function call with the wrong number or types of arguments
+const TypeInvalidFunctionCall string = "invalid_function_call"
+
+// InvalidFunctionCall is an invalid function call issue
+type InvalidFunctionCall struct {
+	baseIssue
+
+	Template string `json:"template"`
+	Offset   int    `json:"offset"`
+	Function string `json:"function"`
+}
+
+func newInvalidFunctionCall(nodeUUID flows.NodeUUID, actionUUID flows.ActionUUID, language envs.Language, template string, offset int, function, message string) *InvalidFunctionCall {
+	return &InvalidFunctionCall{
+		baseIssue: newBaseIssue(
+			TypeInvalidFunctionCall,
+			nodeUUID,
+			actionUUID,
+			language,
+			message,
+		),
+		Template: template,
+		Offset:   offset,
+		Function: function,
+	}
+}
+
+// InvalidFunctionCallCheck checks for function calls with the wrong number of arguments or arguments of the wrong type
+func InvalidFunctionCallCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema, report func(flows.Issue)) {
+	checkTemplates(tpls, schema, func(t flows.ExtractedTemplate, d *tools.Diagnostic) {
+		if d.Type != tools.DiagnosticArgCount && d.Type != tools.DiagnosticArgType {
+			return
+		}
+
+		var actionUUID flows.ActionUUID
+		if t.Action != nil {
+			actionUUID = t.Action.UUID()
+		}
+		report(newInvalidFunctionCall(t.Node.UUID(), actionUUID, t.Language, t.Template, d.Offset, d.Function, d.Message))
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/flows/inspect"
 	"github.com/nyaruka/goflow/flows/routers"
@@ -39,7 +40,7 @@ func newInvalidRegex(nodeUUID flows.NodeUUID, actionUUID flows.ActionUUID, langu
 }
 
 // InvalidRegexCheck checks for invalid regexes
-func InvalidRegexCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, report func(flows.Issue)) {
+func InvalidRegexCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema, report func(flows.Issue)) {
 	checkTemplate := func(n flows.Node, a flows.Action, l envs.Language, t string) {
 		// only check if template doesn't contain expressions
 		if !excellent.HasExpressions(t, flows.RunContextTopLevels) {
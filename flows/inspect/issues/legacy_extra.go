@@ -33,7 +33,7 @@ func newLegacyExtra(nodeUUID flows.NodeUUID, actionUUID flows.ActionUUID, langua
 }
 
 // LegacyExtraCheck checks for legacy extra usage
-func LegacyExtraCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, report func(flows.Issue)) {
+func LegacyExtraCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema, report func(flows.Issue)) {
 	for _, t := range tpls {
 		usesLegacyExtra := false
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/flows/inspect"
 )
@@ -37,7 +38,7 @@ func newMissingDependency(nodeUUID flows.NodeUUID, actionUUID flows.ActionUUID,
 }
 
 // MissingDependencyCheck checks for missing dependencies
-func MissingDependencyCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, report func(flows.Issue)) {
+func MissingDependencyCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema, report func(flows.Issue)) {
 	// skip check if we don't have assets
 	if sa == nil {
 		return
@@ -0,0 +1,57 @@
+package issues
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/tools"
+	"github.com/nyaruka/goflow/flows"
+)
+
+func init() {
+	registerType(TypeUnknownContextPath, UnknownContextPathCheck)
+}
+
+// TypeUnknownContextPath is our type for a reference to a path which doesn't exist in the context
+const TypeUnknownContextPath string = "unknown_context_path"
+
+// UnknownContextPath is an unknown context path issue
+type UnknownContextPath struct {
+	baseIssue
+
+	Template string `json:"template"`
+	Offset   int    `json:"offset"`
+	Path     string `json:"path"`
+}
+
+func newUnknownContextPath(nodeUUID flows.NodeUUID, actionUUID flows.ActionUUID, language envs.Language, template string, offset int, path string) *UnknownContextPath {
+	return &UnknownContextPath{
+		baseIssue: newBaseIssue(
+			TypeUnknownContextPath,
+			nodeUUID,
+			actionUUID,
+			language,
+			fmt.Sprintf("unknown context path @%s", path),
+		),
+		Template: template,
+		Offset:   offset,
+		Path:     path,
+	}
+}
+
+// UnknownContextPathCheck checks for references to paths which don't exist in the context
+func UnknownContextPathCheck(sa flows.SessionAssets, flow flows.Flow, tpls []flows.ExtractedTemplate, refs []flows.ExtractedReference, schema *tools.Schema, report func(flows.Issue)) {
+	checkTemplates(tpls, schema, func(t flows.ExtractedTemplate, d *tools.Diagnostic) {
+		// uses of @legacy_extra have their own issue type
+		if d.Type != tools.DiagnosticUnknownPath || strings.HasPrefix(strings.ToLower(d.Path), "legacy_extra") {
+			return
+		}
+
+		var actionUUID flows.ActionUUID
+		if t.Action != nil {
+			actionUUID = t.Action.UUID()
+		}
+		report(newUnknownContextPath(t.Node.UUID(), actionUUID, t.Language, t.Template, d.Offset, d.Path))
+	})
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/nyaruka/goflow/contactql"
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent"
+	"github.com/nyaruka/goflow/excellent/tools"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
 	"github.com/nyaruka/goflow/utils/uuids"
 )
 
@@ -81,6 +83,9 @@ const (
 
 	// SessionStatusFailed represents a session that encountered an unrecoverable error
 	SessionStatusFailed SessionStatus = "failed"
+
+	// SessionStatusInterrupted represents a session that was ended by something outside of the flow, e.g. a call hanging up
+	SessionStatusInterrupted SessionStatus = "interrupted"
 )
 
 // RunStatus represents the current status of the flow run
@@ -101,6 +106,9 @@ const (
 
 	// RunStatusExpired represents a run that expired due to inactivity
 	RunStatusExpired RunStatus = "expired"
+
+	// RunStatusInterrupted represents a run that was ended by something outside of the flow, e.g. a call hanging up
+	RunStatusInterrupted RunStatus = "interrupted"
 )
 
 // FlowAssets provides access to flow assets
@@ -144,12 +152,13 @@ type Flow interface {
 	Type() FlowType
 	ExpireAfterMinutes() int
 	Localization() Localization
+	PayloadSchema() *jsonx.Schema
 	UI() json.RawMessage
 	Nodes() []Node
 	GetNode(uuid NodeUUID) Node
 	Reference() *assets.FlowReference
 
-	Inspect(sa SessionAssets) *Inspection
+	Inspect(sa SessionAssets, schema *tools.Schema) *Inspection
 	ExtractTemplates() []string
 	ExtractLocalizables() []string
 	ChangeLanguage(envs.Language) (Flow, error)
@@ -209,6 +218,15 @@ type Router interface {
 	EnumerateLocalizables(func(uuids.UUID, string, []string, func([]string)))
 }
 
+// RetryingRouter is a router which, rather than picking an exit, can ask the engine to wait again on the same node.
+// It does so by calling the given function which returns whether the wait began, and if it didn't, the router picks
+// an exit instead.
+type RetryingRouter interface {
+	Router
+
+	RouteOrRetry(FlowRun, Step, func() bool, EventCallback) (ExitUUID, bool, error)
+}
+
 // Exit is a route out of a node and optionally to another node
 type Exit interface {
 	UUID() ExitUUID
@@ -286,6 +304,14 @@ type Resume interface {
 	ResumedOn() time.Time
 }
 
+// ResumeWithDestination is special case of resume which moves the waiting run to another node in its flow rather
+// than routing the node it was waiting on
+type ResumeWithDestination interface {
+	Resume
+
+	NodeUUID() NodeUUID
+}
+
 // Modifier is something which can modify a contact
 type Modifier interface {
 	utils.Typed
@@ -327,7 +353,9 @@ type Step interface {
 	ExitUUID() ExitUUID
 	ArrivedOn() time.Time
 	WantsResponse() bool
+	Retries() int
 	Leave(ExitUUID)
+	Retry()
 }
 
 // Engine provides callers with session starting and resuming
@@ -338,6 +366,8 @@ type Engine interface {
 	Services() Services
 	MaxStepsPerSprint() int
 	MaxTemplateChars() int
+	CaseTracing() bool
+	TemplateCache() *excellent.TemplateCache
 }
 
 // Sprint is an interaction with the engine - i.e. a start or resume of a session
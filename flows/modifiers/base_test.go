@@ -305,3 +305,69 @@ func TestFieldValueTypes(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "39 years", mod.(*modifiers.FieldModifier).Value())
 }
+
+func TestDryRun(t *testing.T) {
+	env := envs.NewBuilder().Build()
+	sa, err := test.LoadSessionAssets(env, "testdata/_assets.json")
+	require.NoError(t, err)
+
+	contact, err := flows.ReadContact(sa, []byte(`{
+		"uuid": "5d76d86b-3bb9-4d5a-b822-c9d86f5d8e4f",
+		"name": "Bob",
+		"status": "active",
+		"language": "eng",
+		"created_on": "2018-06-20T11:40:30.123456789Z",
+		"urns": ["tel:+12065551212"],
+		"groups": [{"uuid": "1e1ce1e1-9288-4504-869e-022d1003c72a", "name": "Customers"}],
+		"fields": {"age": {"text": "37", "number": 37}}
+	}`), assets.PanicOnMissing)
+	require.NoError(t, err)
+
+	gender := sa.Fields().Get("gender")
+	age := sa.Fields().Get("age")
+	customers := sa.Groups().Get("1e1ce1e1-9288-4504-869e-022d1003c72a")
+
+	diff, evts := modifiers.DryRun(env, sa, contact, []flows.Modifier{
+		modifiers.NewName("Robert"),
+		modifiers.NewLanguage("fra"),
+		modifiers.NewURNs([]urns.URN{"tel:+12065552020"}, modifiers.URNsSet),
+		modifiers.NewField(gender, "Male"),
+		modifiers.NewField(age, ""),
+		modifiers.NewGroups([]*flows.Group{customers}, modifiers.GroupsRemove),
+	})
+
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, 8, len(evts))
+
+	diffJSON, err := jsonx.Marshal(diff)
+	require.NoError(t, err)
+	test.AssertEqualJSON(t, []byte(`{
+		"name": {"old": "Bob", "new": "Robert"},
+		"language": {"old": "eng", "new": "fra"},
+		"urns_added": ["tel:+12065552020"],
+		"urns_removed": ["tel:+12065551212"],
+		"fields": {
+			"age": {"old": {"text": "37", "number": 37}, "new": null},
+			"gender": {"old": null, "new": {"text": "Male"}}
+		},
+		"groups_added": [
+			{"uuid": "aa704054-95ea-49e4-b9d7-12090afb5403", "name": "Francophones"},
+			{"uuid": "0ec97956-c451-48a0-a180-1ce766623e31", "name": "Males"}
+		],
+		"groups_removed": [
+			{"uuid": "1e1ce1e1-9288-4504-869e-022d1003c72a", "name": "Customers"}
+		]
+	}`), diffJSON, "diff mismatch")
+
+	// original contact is unchanged
+	assert.Equal(t, "Bob", contact.Name())
+	assert.Equal(t, envs.Language("eng"), contact.Language())
+	assert.Equal(t, 1, len(contact.URNs()))
+	assert.Equal(t, 1, contact.Groups().Count())
+	assert.Nil(t, contact.Fields().Get(gender))
+
+	// no modifiers means no changes
+	diff, evts = modifiers.DryRun(env, sa, contact, nil)
+	assert.True(t, diff.IsEmpty())
+	assert.Equal(t, 0, len(evts))
+}
@@ -0,0 +1,142 @@
+package modifiers
+
+import (
+	"sort"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+)
+
+// Change is a change to a simple contact property
+type Change struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// FieldChange is a change to a contact field value
+type FieldChange struct {
+	Old *flows.Value `json:"old"`
+	New *flows.Value `json:"new"`
+}
+
+// ContactDiff describes the differences between two versions of a contact
+type ContactDiff struct {
+	Name          *Change                  `json:"name,omitempty"`
+	Language      *Change                  `json:"language,omitempty"`
+	Status        *Change                  `json:"status,omitempty"`
+	Timezone      *Change                  `json:"timezone,omitempty"`
+	URNsAdded     []urns.URN               `json:"urns_added,omitempty"`
+	URNsRemoved   []urns.URN               `json:"urns_removed,omitempty"`
+	Fields        map[string]*FieldChange  `json:"fields,omitempty"`
+	GroupsAdded   []*assets.GroupReference `json:"groups_added,omitempty"`
+	GroupsRemoved []*assets.GroupReference `json:"groups_removed,omitempty"`
+}
+
+// IsEmpty returns whether this diff has no changes
+func (d *ContactDiff) IsEmpty() bool {
+	return d.Name == nil && d.Language == nil && d.Status == nil && d.Timezone == nil &&
+		len(d.URNsAdded) == 0 && len(d.URNsRemoved) == 0 && len(d.Fields) == 0 &&
+		len(d.GroupsAdded) == 0 && len(d.GroupsRemoved) == 0
+}
+
+// DryRun applies the given modifiers to a clone of the given contact, and returns what would change along with the
+// events that would be generated. The given contact isn't modified.
+func DryRun(env envs.Environment, sa flows.SessionAssets, contact *flows.Contact, mods []flows.Modifier) (*ContactDiff, []flows.Event) {
+	clone := contact.Clone()
+	evts := make([]flows.Event, 0)
+
+	for _, mod := range mods {
+		mod.Apply(env, sa, clone, func(e flows.Event) { evts = append(evts, e) })
+	}
+
+	return Diff(contact, clone), evts
+}
+
+// Diff returns the differences between the given before and after versions of a contact
+func Diff(before, after *flows.Contact) *ContactDiff {
+	d := &ContactDiff{
+		Name:     diffString(before.Name(), after.Name()),
+		Language: diffString(string(before.Language()), string(after.Language())),
+		Status:   diffString(string(before.Status()), string(after.Status())),
+		Timezone: diffString(timezoneName(before), timezoneName(after)),
+	}
+
+	d.URNsAdded = urnsNotIn(after.URNs(), before)
+	d.URNsRemoved = urnsNotIn(before.URNs(), after)
+
+	for _, key := range fieldKeys(before, after) {
+		oldValue, newValue := fieldValue(before, key), fieldValue(after, key)
+		if !oldValue.Equals(newValue) {
+			if d.Fields == nil {
+				d.Fields = make(map[string]*FieldChange)
+			}
+			d.Fields[key] = &FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+
+	d.GroupsAdded = groupsNotIn(after.Groups(), before.Groups())
+	d.GroupsRemoved = groupsNotIn(before.Groups(), after.Groups())
+
+	return d
+}
+
+func diffString(old, new string) *Change {
+	if old == new {
+		return nil
+	}
+	return &Change{Old: old, New: new}
+}
+
+func timezoneName(contact *flows.Contact) string {
+	if contact.Timezone() == nil {
+		return ""
+	}
+	return contact.Timezone().String()
+}
+
+// gets the URNs in the given list which the given contact doesn't have
+func urnsNotIn(urnList flows.URNList, contact *flows.Contact) []urns.URN {
+	var missing []urns.URN
+	for _, u := range urnList {
+		if !contact.HasURN(u.URN()) {
+			missing = append(missing, u.URN())
+		}
+	}
+	return missing
+}
+
+// gets references to the groups in list a which aren't in list b
+func groupsNotIn(a, b *flows.GroupList) []*assets.GroupReference {
+	var missing []*assets.GroupReference
+	for _, g := range a.All() {
+		if b.FindByUUID(g.UUID()) == nil {
+			missing = append(missing, g.Reference())
+		}
+	}
+	return missing
+}
+
+// gets the keys of all the fields which have a value on any of the given contacts, in order
+func fieldKeys(contacts ...*flows.Contact) []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	for _, c := range contacts {
+		for key, fv := range c.Fields() {
+			if fv != nil && !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fieldValue(contact *flows.Contact, key string) *flows.Value {
+	if fv := contact.Fields()[key]; fv != nil {
+		return fv.Value
+	}
+	return nil
+}
@@ -48,8 +48,9 @@ func (m *FieldModifier) Apply(env envs.Environment, sa flows.SessionAssets, cont
 	}
 
 	if !newValue.Equals(oldValue) {
-		contact.Fields().Set(m.field, newValue)
-		log(events.NewContactFieldChanged(m.field, newValue))
+		event := events.NewContactFieldChanged(m.field, newValue, oldValue)
+		contact.ChangeField(m.field, newValue, event.CreatedOn())
+		log(event)
 		m.reevaluateGroups(env, sa, contact, log)
 	}
 }
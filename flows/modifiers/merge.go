@@ -0,0 +1,167 @@
+package modifiers
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+)
+
+func init() {
+	registerType(TypeMerge, readMergeModifier)
+}
+
+// TypeMerge is the type of our merge modifier
+const TypeMerge string = "merge"
+
+// MergeStrategy is how a value is chosen when both contacts have one
+type MergeStrategy string
+
+// the supported merge strategies
+const (
+	MergeKeep      MergeStrategy = "keep"
+	MergeOverwrite MergeStrategy = "overwrite"
+)
+
+// MergeModifier merges another contact into the contact. URNs and static groups of the other contact are added,
+// and its field values and language are copied to the contact where it has none. Where both contacts have a value,
+// the strategy for that field (or the default strategy) decides which is kept.
+type MergeModifier struct {
+	baseModifier
+
+	other           *flows.Contact
+	strategy        MergeStrategy
+	fieldStrategies map[string]MergeStrategy
+}
+
+// NewMerge creates a new merge modifier
+func NewMerge(other *flows.Contact, strategy MergeStrategy, fieldStrategies map[string]MergeStrategy) *MergeModifier {
+	return &MergeModifier{
+		baseModifier:    newBaseModifier(TypeMerge),
+		other:           other,
+		strategy:        strategy,
+		fieldStrategies: fieldStrategies,
+	}
+}
+
+// Apply applies this modification to the given contact
+func (m *MergeModifier) Apply(env envs.Environment, sa flows.SessionAssets, contact *flows.Contact, log flows.EventCallback) {
+	// add any URNs the contact doesn't already have
+	urnsAdded := make([]urns.URN, 0)
+	for _, u := range m.other.URNs() {
+		if contact.AddURN(u.URN(), u.Channel()) {
+			urnsAdded = append(urnsAdded, u.URN())
+		}
+	}
+	if len(urnsAdded) > 0 {
+		log(events.NewContactURNsChanged(contact.URNs().RawURNs()))
+	}
+
+	// copy field values according to each field's strategy
+	fieldsChanged := make([]string, 0)
+	for _, field := range sa.Fields().All() {
+		newValue := m.other.Fields().Get(field)
+		oldValue := contact.Fields().Get(field)
+
+		if newValue == nil || newValue.Equals(oldValue) {
+			continue
+		}
+		if oldValue != nil && m.strategyFor(field.Key()) == MergeKeep {
+			continue
+		}
+
+		event := events.NewContactFieldChanged(field, newValue, oldValue)
+		contact.ChangeField(field, newValue, event.CreatedOn())
+		log(event)
+		fieldsChanged = append(fieldsChanged, field.Key())
+	}
+
+	// add the contact to any static groups the other contact is in
+	groupsAdded := make([]*flows.Group, 0)
+	for _, group := range m.other.Groups().All() {
+		if group.IsDynamic() || contact.Groups().FindByUUID(group.UUID()) != nil {
+			continue
+		}
+
+		contact.Groups().Add(group)
+		groupsAdded = append(groupsAdded, group)
+	}
+	if len(groupsAdded) > 0 {
+		log(events.NewContactGroupsChanged(groupsAdded, nil))
+	}
+
+	// take the other contact's language according to the default strategy
+	languageChanged := false
+	if m.other.Language() != envs.NilLanguage && m.other.Language() != contact.Language() {
+		if contact.Language() == envs.NilLanguage || m.strategy == MergeOverwrite {
+			contact.SetLanguage(m.other.Language())
+			log(events.NewContactLanguageChanged(m.other.Language()))
+			languageChanged = true
+		}
+	}
+
+	if len(urnsAdded) > 0 || len(fieldsChanged) > 0 || len(groupsAdded) > 0 || languageChanged {
+		m.reevaluateGroups(env, sa, contact, log)
+	}
+
+	log(events.NewContactMerged(m.other.Reference(), urnsAdded, fieldsChanged, groupsAdded, languageChanged))
+}
+
+// gets the strategy to use for the field with the given key
+func (m *MergeModifier) strategyFor(key string) MergeStrategy {
+	if s, ok := m.fieldStrategies[key]; ok {
+		return s
+	}
+	return m.strategy
+}
+
+var _ flows.Modifier = (*MergeModifier)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type mergeModifierEnvelope struct {
+	utils.TypedEnvelope
+	Contact         json.RawMessage          `json:"contact" validate:"required"`
+	Strategy        MergeStrategy            `json:"strategy,omitempty" validate:"omitempty,eq=keep|eq=overwrite"`
+	FieldStrategies map[string]MergeStrategy `json:"field_strategies,omitempty" validate:"omitempty,dive,eq=keep|eq=overwrite"`
+}
+
+func readMergeModifier(assets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Modifier, error) {
+	e := &mergeModifierEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	other, err := flows.ReadContact(assets, e.Contact, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := e.Strategy
+	if strategy == "" {
+		strategy = MergeKeep
+	}
+
+	return NewMerge(other, strategy, e.FieldStrategies), nil
+}
+
+func (m *MergeModifier) MarshalJSON() ([]byte, error) {
+	contact, err := jsonx.Marshal(m.other)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(&mergeModifierEnvelope{
+		TypedEnvelope:   utils.TypedEnvelope{Type: m.Type()},
+		Contact:         contact,
+		Strategy:        m.strategy,
+		FieldStrategies: m.fieldStrategies,
+	})
+}
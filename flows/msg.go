@@ -88,6 +88,25 @@ func NewMsgOut(urn urns.URN, channel *assets.ChannelReference, text string, atta
 	}
 }
 
+// NewMsgsOutForDestinations creates an outgoing message for each of the given URN and channel destinations by calling
+// create with each URN and channel. If there are no destinations, a single message is created without a URN or channel
+// and it's up to the caller to handle that as they want.
+func NewMsgsOutForDestinations(destinations []Destination, create func(urns.URN, *assets.ChannelReference) *MsgOut) []*MsgOut {
+	if len(destinations) == 0 {
+		return []*MsgOut{create(urns.NilURN, nil)}
+	}
+
+	msgs := make([]*MsgOut, len(destinations))
+	for i, dest := range destinations {
+		var channelRef *assets.ChannelReference
+		if dest.Channel != nil {
+			channelRef = assets.NewChannelReference(dest.Channel.UUID(), dest.Channel.Name())
+		}
+		msgs[i] = create(dest.URN.URN(), channelRef)
+	}
+	return msgs
+}
+
 // NewIVRMsgOut creates a new outgoing message for IVR
 func NewIVRMsgOut(urn urns.URN, channel *assets.ChannelReference, text string, textLanguage envs.Language, audioURL string) *MsgOut {
 	var attachments []utils.Attachment
@@ -0,0 +1,115 @@
+package resumes
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+)
+
+func init() {
+	registerType(TypeCallStatus, readCallStatusResume)
+}
+
+// TypeCallStatus is the type for resuming a voice session when the status of the call changes
+const TypeCallStatus string = "call_status"
+
+// CallStatusResume is used when a voice session is resumed because the status of the call changed. If the call was
+// hung up, all runs in the session and the session itself are interrupted. If a transfer was completed, the waiting
+// run continues.
+//
+//   {
+//     "type": "call_status",
+//     "contact": {
+//       "uuid": "9f7ede93-4b16-4692-80ad-b7dc54a1cd81",
+//       "name": "Bob",
+//       "created_on": "2018-01-01T12:00:00.000000Z",
+//       "language": "fra",
+//       "fields": {"gender": {"text": "Male"}},
+//       "groups": []
+//     },
+//     "status": "hangup",
+//     "resumed_on": "2000-01-01T00:00:00.000000000-00:00"
+//   }
+//
+// @resume call_status
+type CallStatusResume struct {
+	baseResume
+	status flows.ConnectionStatus
+}
+
+// NewCallStatus creates a new call status resume with the passed in values
+func NewCallStatus(env envs.Environment, contact *flows.Contact, status flows.ConnectionStatus) *CallStatusResume {
+	return &CallStatusResume{
+		baseResume: newBaseResume(TypeCallStatus, env, contact),
+		status:     status,
+	}
+}
+
+// Status returns the new status of the call
+func (r *CallStatusResume) Status() flows.ConnectionStatus { return r.status }
+
+// Apply applies our state changes and saves any events to the run
+func (r *CallStatusResume) Apply(run flows.FlowRun, logEvent flows.EventCallback) error {
+	logEvent(events.NewCallStatusChanged(r.status))
+
+	if r.status == flows.ConnectionStatusHangup {
+		// there's no longer anyone on the call so interrupt every run which hasn't already ended
+		for _, sessionRun := range run.Session().Runs() {
+			if sessionRun.ExitedOn() == nil {
+				sessionRun.Exit(flows.RunStatusInterrupted)
+			}
+		}
+	} else {
+		// clear the last input
+		run.Session().SetInput(nil)
+		run.ResetExpiration(nil)
+	}
+
+	return r.baseResume.Apply(run, logEvent)
+}
+
+var _ flows.Resume = (*CallStatusResume)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type callStatusResumeEnvelope struct {
+	baseResumeEnvelope
+	Status flows.ConnectionStatus `json:"status" validate:"required,eq=hangup|eq=transfer_completed"`
+}
+
+func readCallStatusResume(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Resume, error) {
+	e := &callStatusResumeEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &CallStatusResume{
+		status: e.Status,
+	}
+
+	if err := r.unmarshal(sessionAssets, &e.baseResumeEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this resume into JSON
+func (r *CallStatusResume) MarshalJSON() ([]byte, error) {
+	e := &callStatusResumeEnvelope{
+		Status: r.status,
+	}
+
+	if err := r.marshal(&e.baseResumeEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
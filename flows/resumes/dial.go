@@ -0,0 +1,107 @@
+package resumes
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+)
+
+func init() {
+	registerType(TypeDial, readDialResume)
+}
+
+// TypeDial is the type for resuming a session with the outcome of a dial
+const TypeDial string = "dial"
+
+// DialResume is used when a session is resumed after the caller was connected (or failed to be connected) to
+// another number by a dial wait
+//
+//   {
+//     "type": "dial",
+//     "contact": {
+//       "uuid": "9f7ede93-4b16-4692-80ad-b7dc54a1cd81",
+//       "name": "Bob",
+//       "created_on": "2018-01-01T12:00:00.000000Z",
+//       "language": "fra",
+//       "fields": {"gender": {"text": "Male"}},
+//       "groups": []
+//     },
+//     "dial": {
+//       "status": "answered",
+//       "duration": 5
+//     },
+//     "resumed_on": "2000-01-01T00:00:00.000000000-00:00"
+//   }
+//
+// @resume dial
+type DialResume struct {
+	baseResume
+	dial *flows.Dial
+}
+
+// NewDial creates a new dial resume with the passed in values
+func NewDial(env envs.Environment, contact *flows.Contact, dial *flows.Dial) *DialResume {
+	return &DialResume{
+		baseResume: newBaseResume(TypeDial, env, contact),
+		dial:       dial,
+	}
+}
+
+// Dial returns the outcome of the dial
+func (r *DialResume) Dial() *flows.Dial { return r.dial }
+
+// Apply applies our state changes and saves any events to the run
+func (r *DialResume) Apply(run flows.FlowRun, logEvent flows.EventCallback) error {
+	// clear the last input
+	run.Session().SetInput(nil)
+	run.ResetExpiration(nil)
+	logEvent(events.NewDialEnded(r.dial))
+
+	return r.baseResume.Apply(run, logEvent)
+}
+
+var _ flows.Resume = (*DialResume)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type dialResumeEnvelope struct {
+	baseResumeEnvelope
+	Dial *flows.Dial `json:"dial" validate:"required,dive"`
+}
+
+func readDialResume(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Resume, error) {
+	e := &dialResumeEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &DialResume{
+		dial: e.Dial,
+	}
+
+	if err := r.unmarshal(sessionAssets, &e.baseResumeEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this resume into JSON
+func (r *DialResume) MarshalJSON() ([]byte, error) {
+	e := &dialResumeEnvelope{
+		Dial: r.dial,
+	}
+
+	if err := r.marshal(&e.baseResumeEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
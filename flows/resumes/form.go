@@ -0,0 +1,108 @@
+package resumes
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/inputs"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+)
+
+func init() {
+	registerType(TypeForm, readFormResume)
+}
+
+// TypeForm is the type for resuming a session with a form submission
+const TypeForm string = "form"
+
+// FormResume is used when a session is resumed with a form submitted by the contact
+//
+//   {
+//     "type": "form",
+//     "contact": {
+//       "uuid": "9f7ede93-4b16-4692-80ad-b7dc54a1cd81",
+//       "name": "Bob",
+//       "created_on": "2018-01-01T12:00:00.000000Z",
+//       "language": "fra",
+//       "fields": {"gender": {"text": "Male"}},
+//       "groups": []
+//     },
+//     "form": {
+//       "uuid": "9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a",
+//       "channel": {"uuid": "61602f3e-f603-4c70-8a8f-c477505bf4bf", "name": "WhatsApp"},
+//       "answers": {"name": "Bob", "age": 32, "subscribe": true}
+//     },
+//     "resumed_on": "2000-01-01T00:00:00.000000000-00:00"
+//   }
+//
+// @resume form
+type FormResume struct {
+	baseResume
+	form *flows.FormIn
+}
+
+// NewForm creates a new form resume with the passed in values
+func NewForm(env envs.Environment, contact *flows.Contact, form *flows.FormIn) *FormResume {
+	return &FormResume{
+		baseResume: newBaseResume(TypeForm, env, contact),
+		form:       form,
+	}
+}
+
+// Form returns the form submission this resume is based on
+func (r *FormResume) Form() *flows.FormIn { return r.form }
+
+// Apply applies our state changes and saves any events to the run
+func (r *FormResume) Apply(run flows.FlowRun, logEvent flows.EventCallback) error {
+	// update our input
+	run.Session().SetInput(inputs.NewForm(run.Session().Assets(), r.form, r.ResumedOn()))
+	run.ResetExpiration(nil)
+	logEvent(events.NewFormReceived(r.form))
+
+	return r.baseResume.Apply(run, logEvent)
+}
+
+var _ flows.Resume = (*FormResume)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type formResumeEnvelope struct {
+	baseResumeEnvelope
+	Form *flows.FormIn `json:"form" validate:"required,dive"`
+}
+
+func readFormResume(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Resume, error) {
+	e := &formResumeEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &FormResume{
+		form: e.Form,
+	}
+
+	if err := r.unmarshal(sessionAssets, &e.baseResumeEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this resume into JSON
+func (r *FormResume) MarshalJSON() ([]byte, error) {
+	e := &formResumeEnvelope{
+		Form: r.form,
+	}
+
+	if err := r.marshal(&e.baseResumeEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
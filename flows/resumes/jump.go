@@ -0,0 +1,129 @@
+package resumes
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeJump, readJumpResume)
+}
+
+// TypeJump is the type for resuming a session by moving the waiting run to another node
+const TypeJump string = "jump"
+
+// JumpResume is used when a user moves a waiting run to another node in its flow, e.g. to move a stuck contact
+// forward. The node must exist in the flow of the waiting run or the session fails. The session continues from that
+// node without the waiting node being routed.
+//
+//   {
+//     "type": "jump",
+//     "contact": {
+//       "uuid": "9f7ede93-4b16-4692-80ad-b7dc54a1cd81",
+//       "name": "Bob",
+//       "created_on": "2018-01-01T12:00:00.000000Z",
+//       "language": "fra",
+//       "fields": {"gender": {"text": "Male"}},
+//       "groups": []
+//     },
+//     "node_uuid": "f5bb9b7a-7b5e-45c3-8f0e-61b4e95edf03",
+//     "user": "bob@nyaruka.com",
+//     "reason": "Contact was stuck on the age question",
+//     "resumed_on": "2000-01-01T00:00:00.000000000-00:00"
+//   }
+//
+// @resume jump
+type JumpResume struct {
+	baseResume
+	nodeUUID flows.NodeUUID
+	user     string
+	reason   string
+}
+
+// NewJump creates a new jump resume with the passed in values
+func NewJump(env envs.Environment, contact *flows.Contact, nodeUUID flows.NodeUUID, user, reason string) *JumpResume {
+	return &JumpResume{
+		baseResume: newBaseResume(TypeJump, env, contact),
+		nodeUUID:   nodeUUID,
+		user:       user,
+		reason:     reason,
+	}
+}
+
+// NodeUUID returns the UUID of the node to jump to
+func (r *JumpResume) NodeUUID() flows.NodeUUID { return r.nodeUUID }
+
+// User returns the user who made the jump
+func (r *JumpResume) User() string { return r.user }
+
+// Reason returns the reason given for the jump
+func (r *JumpResume) Reason() string { return r.reason }
+
+// Apply applies our state changes and saves any events to the run
+func (r *JumpResume) Apply(run flows.FlowRun, logEvent flows.EventCallback) error {
+	if run.Flow().GetNode(r.nodeUUID) == nil {
+		return errors.Errorf("can't jump to node %s which doesn't exist in flow %s", r.nodeUUID, run.Flow().UUID())
+	}
+
+	// clear the last input
+	run.Session().SetInput(nil)
+	run.ResetExpiration(nil)
+	logEvent(events.NewRunJumped(run, r.nodeUUID, r.user, r.reason))
+
+	return r.baseResume.Apply(run, logEvent)
+}
+
+var _ flows.ResumeWithDestination = (*JumpResume)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type jumpResumeEnvelope struct {
+	baseResumeEnvelope
+	NodeUUID flows.NodeUUID `json:"node_uuid" validate:"required,uuid4"`
+	User     string         `json:"user"      validate:"required"`
+	Reason   string         `json:"reason,omitempty"`
+}
+
+func readJumpResume(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Resume, error) {
+	e := &jumpResumeEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &JumpResume{
+		nodeUUID: e.NodeUUID,
+		user:     e.User,
+		reason:   e.Reason,
+	}
+
+	if err := r.unmarshal(sessionAssets, &e.baseResumeEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this resume into JSON
+func (r *JumpResume) MarshalJSON() ([]byte, error) {
+	e := &jumpResumeEnvelope{
+		NodeUUID: r.nodeUUID,
+		User:     r.user,
+		Reason:   r.reason,
+	}
+
+	if err := r.marshal(&e.baseResumeEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/nyaruka/goflow/assets"
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/engine"
 	"github.com/nyaruka/goflow/flows/routers"
 	"github.com/nyaruka/goflow/flows/triggers"
 	"github.com/nyaruka/goflow/test"
@@ -54,6 +55,7 @@ func testRouterType(t *testing.T, assetsJSON json.RawMessage, typeName string) {
 	tests := []struct {
 		Description string          `json:"description"`
 		Router      json.RawMessage `json:"router"`
+		CaseTracing bool            `json:"case_tracing,omitempty"`
 
 		ReadError         string          `json:"read_error,omitempty"`
 		DependenciesError string          `json:"dependencies_error,omitempty"`
@@ -103,6 +105,10 @@ func testRouterType(t *testing.T, assetsJSON json.RawMessage, typeName string) {
 		trigger := triggers.NewBuilder(envs.NewBuilder().Build(), flow.Reference(), contact).Manual().Build()
 
 		eng := test.NewEngine()
+		if tc.CaseTracing {
+			eng = engine.NewBuilder().WithCaseTracing(true).Build()
+		}
+
 		session, _, err := eng.NewSession(sa, trigger)
 		require.NoError(t, err)
 
@@ -124,7 +130,7 @@ func testRouterType(t *testing.T, assetsJSON json.RawMessage, typeName string) {
 			actual.LocalizedText = flow.ExtractLocalizables()
 		}
 		if tc.Inspection != nil {
-			actual.Inspection, _ = jsonx.Marshal(flow.Inspect(sa))
+			actual.Inspection, _ = jsonx.Marshal(flow.Inspect(sa, nil))
 		}
 
 		if !test.UpdateSnapshots {
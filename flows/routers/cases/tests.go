@@ -2,6 +2,7 @@ package cases
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -73,6 +74,16 @@ var XTESTS = map[string]types.XFunction{
 	"has_district": functions.MinAndMaxArgsCheck(1, 2, HasDistrict),
 	"has_ward":     HasWard,
 
+	"has_geo_state":    functions.OneTextFunction(HasGeoState),
+	"has_geo_district": functions.OneTextFunction(HasGeoDistrict),
+	"has_geo_ward":     functions.OneTextFunction(HasGeoWard),
+
+	"has_attachment":      functions.TwoArgFunction(HasAttachment),
+	"has_image":           functions.OneArgFunction(HasImage),
+	"has_audio":           functions.OneArgFunction(HasAudio),
+	"has_video":           functions.OneArgFunction(HasVideo),
+	"has_location_within": functions.NumArgsCheck(4, HasLocationWithin),
+
 	// for backward compatibility
 	"has_value": functions.OneTextFunction(HasText),
 }
@@ -717,6 +728,161 @@ func HasWard(env envs.Environment, args ...types.XValue) types.XValue {
 	return FalseResult
 }
 
+// HasGeoState tests whether the GPS coordinates in `text` are within the boundary of a state. Coordinates
+// can be given as a location attachment, e.g. geo:-1.9167,30.0667, or as just the latitude and longitude.
+//
+//   @(has_geo_state("geo:-1.9167,30.0667").match) -> Rwanda > Kigali City
+//   @(has_geo_state("-1.9167, 30.0667").match) -> Rwanda > Kigali City
+//   @(has_geo_state("geo:42.3601,-71.0589")) -> false
+//   @(has_geo_state("Kigali")) -> false
+//
+// @test has_geo_state(text)
+func HasGeoState(env envs.Environment, text types.XText) types.XValue {
+	return testGeoLocation(env, text, flows.LocationLevelState)
+}
+
+// HasGeoDistrict tests whether the GPS coordinates in `text` are within the boundary of a district
+//
+//   @(has_geo_district("geo:-1.9167,30.0667").match) -> Rwanda > Kigali City > Gasabo
+//   @(has_geo_district("geo:-1.95,30.0").match) -> Rwanda > Kigali City > Nyarugenge
+//   @(has_geo_district("geo:-2.05,30.0")) -> false
+//
+// @test has_geo_district(text)
+func HasGeoDistrict(env envs.Environment, text types.XText) types.XValue {
+	return testGeoLocation(env, text, flows.LocationLevelDistrict)
+}
+
+// HasGeoWard tests whether the GPS coordinates in `text` are within the boundary of a ward
+//
+//   @(has_geo_ward("geo:-1.9167,30.0667").match) -> Rwanda > Kigali City > Gasabo > Gisozi
+//   @(has_geo_ward("geo:-1.85,30.2")) -> false
+//
+// @test has_geo_ward(text)
+func HasGeoWard(env envs.Environment, text types.XText) types.XValue {
+	return testGeoLocation(env, text, flows.LocationLevelWard)
+}
+
+// HasAttachment tests whether `attachments` contains an attachment of the given `type`. The type can be a
+// full media type like image/jpeg or just the top-level type like image.
+//
+//   @(has_attachment(input.attachments, "image")) -> true
+//   @(has_attachment(input.attachments, "image").match) -> image/jpeg:http://s3.amazon.com/bucket/test.jpg
+//   @(has_attachment(input.attachments, "audio/mp3").match) -> audio/mp3:http://s3.amazon.com/bucket/test.mp3
+//   @(has_attachment(input.attachments, "audio/wav")) -> false
+//   @(has_attachment("geo:-1.9167,30.0667", "geo").match) -> geo:-1.9167,30.0667
+//
+// @test has_attachment(attachments, type)
+func HasAttachment(env envs.Environment, value types.XValue, typeArg types.XValue) types.XValue {
+	attachments, xerr := attachmentsFromXValue(env, value)
+	if xerr != nil {
+		return xerr
+	}
+	contentType, xerr := types.ToXText(env, typeArg)
+	if xerr != nil {
+		return xerr
+	}
+
+	return testAttachments(attachments, contentType.Native())
+}
+
+// HasImage tests whether `attachments` contains an image attachment
+//
+//   @(has_image(input.attachments)) -> true
+//   @(has_image(input.attachments).match) -> image/jpeg:http://s3.amazon.com/bucket/test.jpg
+//   @(has_image(array("audio/mp3:http://s3.amazon.com/bucket/test.mp3"))) -> false
+//
+// @test has_image(attachments)
+func HasImage(env envs.Environment, value types.XValue) types.XValue {
+	attachments, xerr := attachmentsFromXValue(env, value)
+	if xerr != nil {
+		return xerr
+	}
+
+	return testAttachments(attachments, "image")
+}
+
+// HasAudio tests whether `attachments` contains an audio attachment
+//
+//   @(has_audio(input.attachments)) -> true
+//   @(has_audio(input.attachments).match) -> audio/mp3:http://s3.amazon.com/bucket/test.mp3
+//   @(has_audio(array("image/jpeg:http://s3.amazon.com/bucket/test.jpg"))) -> false
+//
+// @test has_audio(attachments)
+func HasAudio(env envs.Environment, value types.XValue) types.XValue {
+	attachments, xerr := attachmentsFromXValue(env, value)
+	if xerr != nil {
+		return xerr
+	}
+
+	return testAttachments(attachments, "audio")
+}
+
+// HasVideo tests whether `attachments` contains a video attachment
+//
+//   @(has_video(array("video/mp4:http://s3.amazon.com/bucket/test.mp4")).match) -> video/mp4:http://s3.amazon.com/bucket/test.mp4
+//   @(has_video(input.attachments)) -> false
+//
+// @test has_video(attachments)
+func HasVideo(env envs.Environment, value types.XValue) types.XValue {
+	attachments, xerr := attachmentsFromXValue(env, value)
+	if xerr != nil {
+		return xerr
+	}
+
+	return testAttachments(attachments, "video")
+}
+
+// HasLocationWithin tests whether `attachments` contains a location attachment which is within `radius_km`
+// kilometers of the point given by `latitude` and `longitude`.
+//
+//   @(has_location_within("geo:-1.9167,30.0667", -1.95, 30.06, 5)) -> true
+//   @(has_location_within("geo:-1.9167,30.0667", -1.95, 30.06, 5).match) -> geo:-1.9167,30.0667
+//   @(has_location_within("geo:-1.9167,30.0667", -1.95, 30.06, 5).extra.distance) -> 3.78
+//   @(has_location_within("geo:-1.9167,30.0667", -1.95, 30.06, 1)) -> false
+//   @(has_location_within(input.attachments, -1.95, 30.06, 5)) -> false
+//
+// @test has_location_within(attachments, latitude, longitude, radius_km)
+func HasLocationWithin(env envs.Environment, args ...types.XValue) types.XValue {
+	attachments, xerr := attachmentsFromXValue(env, args[0])
+	if xerr != nil {
+		return xerr
+	}
+
+	var lat, lng, radius types.XNumber
+	if lat, xerr = types.ToXNumber(env, args[1]); xerr != nil {
+		return xerr
+	}
+	if lng, xerr = types.ToXNumber(env, args[2]); xerr != nil {
+		return xerr
+	}
+	if radius, xerr = types.ToXNumber(env, args[3]); xerr != nil {
+		return xerr
+	}
+
+	centerLat, _ := lat.Native().Float64()
+	centerLng, _ := lng.Native().Float64()
+	radiusKm, _ := radius.Native().Float64()
+
+	for _, attachment := range attachments {
+		if !attachmentHasType(attachment, "geo") {
+			continue
+		}
+
+		pointLat, pointLng, found := ParseGeoPoint(string(attachment))
+		if found {
+			distance := geoDistance(centerLat, centerLng, pointLat, pointLng)
+			if distance <= radiusKm {
+				extra := types.NewXObject(map[string]types.XValue{
+					"distance": types.NewXNumber(decimal.NewFromFloat(distance).Round(2)),
+				})
+				return NewTrueResultWithExtra(types.NewXText(string(attachment)), extra)
+			}
+		}
+	}
+
+	return FalseResult
+}
+
 //------------------------------------------------------------------------------------------
 // Text Test Functions
 //------------------------------------------------------------------------------------------
@@ -940,6 +1106,110 @@ func isDateGTTest(value dates.Date, test dates.Date) bool {
 	return value.Compare(test) > 0
 }
 
+//------------------------------------------------------------------------------------------
+// Location Test Functions
+//------------------------------------------------------------------------------------------
+
+// matches decimal GPS coordinates on their own or as the URL of a geo attachment
+var geoPointRegex = regexp.MustCompile(`(?:^|\s|geo:)([-+]?\d{1,2}\.\d+)\s*,\s*([-+]?\d{1,3}\.\d+)(?:\s|$)`)
+
+// ParseGeoPoint looks for GPS coordinates in the given text and returns the latitude and longitude
+func ParseGeoPoint(text string) (float64, float64, bool) {
+	for _, match := range geoPointRegex.FindAllStringSubmatch(text, -1) {
+		lat, _ := strconv.ParseFloat(match[1], 64)
+		lng, _ := strconv.ParseFloat(match[2], 64)
+
+		if lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180 {
+			return lat, lng, true
+		}
+	}
+	return 0, 0, false
+}
+
+func testGeoLocation(env envs.Environment, text types.XText, level envs.LocationLevel) types.XValue {
+	locations := env.LocationResolver()
+	if locations == nil {
+		return types.NewXErrorf("can't find locations in environment which is not location enabled")
+	}
+
+	pointLocations, ok := locations.(envs.PointLocationResolver)
+	if !ok {
+		return types.NewXErrorf("can't find locations by GPS point in environment which doesn't support it")
+	}
+
+	lat, lng, found := ParseGeoPoint(text.Native())
+	if !found {
+		return FalseResult
+	}
+
+	location := pointLocations.FindLocationByPoint(lat, lng, level)
+	if location != nil {
+		return NewTrueResult(types.NewXText(string(location.Path())))
+	}
+	return FalseResult
+}
+
+// the mean radius of the earth in kilometers
+const earthRadiusKm = 6371.0
+
+// calculates the great-circle distance in kilometers between two points using the haversine formula
+func geoDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRadians := func(d float64) float64 { return d * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+//------------------------------------------------------------------------------------------
+// Attachment Test Functions
+//------------------------------------------------------------------------------------------
+
+// converts an array of attachments or a single attachment into a slice of attachments
+func attachmentsFromXValue(env envs.Environment, value types.XValue) ([]utils.Attachment, types.XError) {
+	if array, isArray := value.(*types.XArray); isArray {
+		attachments := make([]utils.Attachment, 0, array.Count())
+
+		for i := 0; i < array.Count(); i++ {
+			text, xerr := types.ToXText(env, array.Get(i))
+			if xerr != nil {
+				return nil, xerr
+			}
+			attachments = append(attachments, utils.Attachment(text.Native()))
+		}
+		return attachments, nil
+	}
+
+	text, xerr := types.ToXText(env, value)
+	if xerr != nil {
+		return nil, xerr
+	}
+	if text.Empty() {
+		return nil, nil
+	}
+	return []utils.Attachment{utils.Attachment(text.Native())}, nil
+}
+
+// checks whether the given attachment has a content type which matches the given full or top-level type
+func attachmentHasType(attachment utils.Attachment, contentType string) bool {
+	actual := strings.ToLower(attachment.ContentType())
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+
+	return contentType != "" && (actual == contentType || strings.HasPrefix(actual, contentType+"/"))
+}
+
+func testAttachments(attachments []utils.Attachment, contentType string) types.XValue {
+	for _, attachment := range attachments {
+		if attachmentHasType(attachment, contentType) {
+			return NewTrueResult(types.NewXText(string(attachment)))
+		}
+	}
+	return FalseResult
+}
+
 //------------------------------------------------------------------------------------------
 // Result Test helpers
 //------------------------------------------------------------------------------------------
@@ -229,6 +229,43 @@ var testTests = []struct {
 	{"has_phone", []types.XValue{xs("too"), xs("many"), xs("args")}, ERROR},
 	{"has_phone", []types.XValue{}, ERROR},
 
+	{"has_attachment", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg"), xs("audio/mp3:http://example.com/a.mp3")), xs("audio")}, result(xs("audio/mp3:http://example.com/a.mp3"))},
+	{"has_attachment", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg")), xs("IMAGE/JPEG")}, result(xs("image/jpeg:http://example.com/a.jpg"))},
+	{"has_attachment", []types.XValue{xa(xs("image:http://example.com/a.jpg")), xs("image")}, result(xs("image:http://example.com/a.jpg"))},
+	{"has_attachment", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg")), xs("image/png")}, falseResult},
+	{"has_attachment", []types.XValue{xa(xs("imagery/x:http://example.com/a.jpg")), xs("image")}, falseResult},
+	{"has_attachment", []types.XValue{xs("geo:-1.9167,30.0667"), xs("geo")}, result(xs("geo:-1.9167,30.0667"))},
+	{"has_attachment", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg")), xs("")}, falseResult},
+	{"has_attachment", []types.XValue{xa(), xs("image")}, falseResult},
+	{"has_attachment", []types.XValue{nil, xs("image")}, falseResult},
+	{"has_attachment", []types.XValue{ERROR, xs("image")}, ERROR},
+	{"has_attachment", []types.XValue{xa(ERROR), xs("image")}, ERROR},
+	{"has_attachment", []types.XValue{xa(), ERROR}, ERROR},
+	{"has_attachment", []types.XValue{xa()}, ERROR},
+
+	{"has_image", []types.XValue{xa(xs("audio/mp3:http://example.com/a.mp3"), xs("image/png:http://example.com/a.png"))}, result(xs("image/png:http://example.com/a.png"))},
+	{"has_image", []types.XValue{xa(xs("audio/mp3:http://example.com/a.mp3"))}, falseResult},
+	{"has_image", []types.XValue{ERROR}, ERROR},
+	{"has_image", []types.XValue{}, ERROR},
+
+	{"has_audio", []types.XValue{xa(xs("audio/mp3:http://example.com/a.mp3"))}, result(xs("audio/mp3:http://example.com/a.mp3"))},
+	{"has_audio", []types.XValue{xa(xs("video/mp4:http://example.com/a.mp4"))}, falseResult},
+	{"has_audio", []types.XValue{}, ERROR},
+
+	{"has_video", []types.XValue{xa(xs("video/mp4:http://example.com/a.mp4"))}, result(xs("video/mp4:http://example.com/a.mp4"))},
+	{"has_video", []types.XValue{xa(xs("audio/mp3:http://example.com/a.mp3"))}, falseResult},
+	{"has_video", []types.XValue{}, ERROR},
+
+	{"has_location_within", []types.XValue{xa(xs("geo:-1.9167,30.0667")), xn("-1.9167"), xn("30.0667"), xi(1)}, resultWithExtra(xs("geo:-1.9167,30.0667"), types.NewXObject(map[string]types.XValue{"distance": xn("0")}))},
+	{"has_location_within", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg"), xs("geo:-1.9167,30.0667")), xn("-1.95"), xn("30.06"), xi(5)}, resultWithExtra(xs("geo:-1.9167,30.0667"), types.NewXObject(map[string]types.XValue{"distance": xn("3.78")}))},
+	{"has_location_within", []types.XValue{xa(xs("geo:-1.9167,30.0667")), xn("-1.95"), xn("30.06"), xi(3)}, falseResult},
+	{"has_location_within", []types.XValue{xa(xs("image/jpeg:http://example.com/a.jpg")), xn("-1.95"), xn("30.06"), xi(3)}, falseResult},
+	{"has_location_within", []types.XValue{xa(xs("geo:-1.9167,30.0667")), xs("foo"), xn("30.06"), xi(3)}, ERROR},
+	{"has_location_within", []types.XValue{xa(xs("geo:-1.9167,30.0667")), xn("-1.95"), ERROR, xi(3)}, ERROR},
+	{"has_location_within", []types.XValue{xa(xs("geo:-1.9167,30.0667")), xn("-1.95"), xn("30.06"), nil}, ERROR},
+	{"has_location_within", []types.XValue{ERROR, xn("-1.95"), xn("30.06"), xi(3)}, ERROR},
+	{"has_location_within", []types.XValue{xa(), xn("-1.95"), xn("30.06")}, ERROR},
+
 	{
 		"has_group",
 		[]types.XValue{
@@ -525,3 +562,32 @@ func TestParseDecimalFuzzy(t *testing.T) {
 		assert.Equal(t, test.expected, val, "parse decimal failed for input '%s'", test.input)
 	}
 }
+
+func TestParseGeoPoint(t *testing.T) {
+	tests := []struct {
+		input string
+		lat   float64
+		lng   float64
+		found bool
+	}{
+		{"geo:-1.9167,30.0667", -1.9167, 30.0667, true},
+		{"-1.9167,30.0667", -1.9167, 30.0667, true},
+		{"-1.9167, 30.0667", -1.9167, 30.0667, true},
+		{"I'm here\n-1.9167,30.0667", -1.9167, 30.0667, true},
+		{"image/jpeg:http://example.com/1.jpg geo:42.3601,-71.0589", 42.3601, -71.0589, true},
+		{"geo:91.0,30.0", 0, 0, false}, // invalid latitude
+		{"geo:1.0,181.0", 0, 0, false}, // invalid longitude
+		{"1,000", 0, 0, false},
+		{"-1,30", 0, 0, false}, // must be decimals
+		{"Kigali", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, tc := range tests {
+		lat, lng, found := cases.ParseGeoPoint(tc.input)
+
+		assert.Equal(t, tc.found, found, "found mismatch for input '%s'", tc.input)
+		assert.Equal(t, tc.lat, lat, "latitude mismatch for input '%s'", tc.input)
+		assert.Equal(t, tc.lng, lng, "longitude mismatch for input '%s'", tc.input)
+	}
+}
@@ -0,0 +1,166 @@
+package routers
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/routers/waits"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeDial, readDialRouter)
+}
+
+// TypeDial is the constant for our dial router
+const TypeDial string = "dial"
+
+// DialRouter is a router which waits for the caller to be connected to another number and then routes on the status
+// of that dial. If the dial never happened (e.g. the phone number wasn't valid), it takes the failed category. If the
+// session is resumed because a transfer completed, it takes the answered category.
+type DialRouter struct {
+	baseRouter
+
+	answered flows.CategoryUUID
+	noAnswer flows.CategoryUUID
+	busy     flows.CategoryUUID
+	failed   flows.CategoryUUID
+}
+
+// NewDial creates a new dial router
+func NewDial(wait *waits.DialWait, resultName string, categories []flows.Category, answered, noAnswer, busy, failed flows.CategoryUUID) *DialRouter {
+	return &DialRouter{
+		baseRouter: newBaseRouter(TypeDial, wait, resultName, categories),
+		answered:   answered,
+		noAnswer:   noAnswer,
+		busy:       busy,
+		failed:     failed,
+	}
+}
+
+// Validate validates the arguments for this router
+func (r *DialRouter) Validate(exits []flows.Exit) error {
+	if _, isDial := r.wait.(*waits.DialWait); !isDial {
+		return errors.New("dial routers must have a dial wait")
+	}
+
+	// check each status category is valid
+	for _, c := range []flows.CategoryUUID{r.answered, r.noAnswer, r.busy, r.failed} {
+		if !r.isValidCategory(c) {
+			return errors.Errorf("status category %s is not a valid category", c)
+		}
+	}
+
+	return r.validate(exits)
+}
+
+// Route determines which exit to take from a node
+func (r *DialRouter) Route(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) (flows.ExitUUID, error) {
+	dial, transferCompleted := r.lastDial(run, step)
+
+	// a completed transfer is treated as an answered dial but we don't know its duration
+	if transferCompleted {
+		return r.routeToCategory(run, step, r.answered, string(flows.DialStatusAnswered), "", nil, logEvent)
+	}
+	if dial == nil {
+		return r.routeToCategory(run, step, r.failed, string(flows.DialStatusFailed), "", nil, logEvent)
+	}
+
+	var categoryUUID flows.CategoryUUID
+	switch dial.Status {
+	case flows.DialStatusAnswered:
+		categoryUUID = r.answered
+	case flows.DialStatusNoAnswer:
+		categoryUUID = r.noAnswer
+	case flows.DialStatusBusy:
+		categoryUUID = r.busy
+	default:
+		categoryUUID = r.failed
+	}
+
+	extra := types.NewXObject(map[string]types.XValue{"duration": types.NewXNumberFromInt(dial.Duration)})
+
+	return r.routeToCategory(run, step, categoryUUID, string(dial.Status), "", extra, logEvent)
+}
+
+// finds the outcome of the last dial on the given step, or whether the session was instead resumed by a completed transfer
+func (r *DialRouter) lastDial(run flows.FlowRun, step flows.Step) (*flows.Dial, bool) {
+	runEvents := run.Events()
+	for i := len(runEvents) - 1; i >= 0; i-- {
+		if runEvents[i].StepUUID() != step.UUID() {
+			continue
+		}
+
+		switch typed := runEvents[i].(type) {
+		case *events.DialEndedEvent:
+			return typed.Dial, false
+		case *events.CallStatusChangedEvent:
+			if typed.Status == flows.ConnectionStatusTransferCompleted {
+				return nil, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// EnumerateTemplates enumerates all expressions on this object and its children
+func (r *DialRouter) EnumerateTemplates(localization flows.Localization, include func(envs.Language, string)) {
+	if wait, isDial := r.wait.(*waits.DialWait); isDial {
+		include(envs.NilLanguage, wait.Phone())
+	}
+}
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type dialRouterEnvelope struct {
+	baseRouterEnvelope
+
+	Answered flows.CategoryUUID `json:"answered_category_uuid"  validate:"required,uuid4"`
+	NoAnswer flows.CategoryUUID `json:"no_answer_category_uuid" validate:"required,uuid4"`
+	Busy     flows.CategoryUUID `json:"busy_category_uuid"      validate:"required,uuid4"`
+	Failed   flows.CategoryUUID `json:"failed_category_uuid"    validate:"required,uuid4"`
+}
+
+func readDialRouter(data json.RawMessage) (flows.Router, error) {
+	e := &dialRouterEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &DialRouter{
+		answered: e.Answered,
+		noAnswer: e.NoAnswer,
+		busy:     e.Busy,
+		failed:   e.Failed,
+	}
+
+	if err := r.unmarshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this router into JSON
+func (r *DialRouter) MarshalJSON() ([]byte, error) {
+	e := &dialRouterEnvelope{
+		Answered: r.answered,
+		NoAnswer: r.noAnswer,
+		Busy:     r.busy,
+		Failed:   r.failed,
+	}
+
+	if err := r.marshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
@@ -0,0 +1,148 @@
+package routers
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/inputs"
+	"github.com/nyaruka/goflow/flows/routers/waits"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/dates"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeForm, readFormRouter)
+}
+
+// TypeForm is the constant for our form router
+const TypeForm string = "form"
+
+// FormRouter is a router which waits for the contact to submit a form, saves the answer to each field of its wait as
+// a run result, and then takes the completed category if every field was answered or the incomplete category if not.
+// Answers which can't be parsed as the type of their field are logged as errors and treated as unanswered.
+type FormRouter struct {
+	baseRouter
+
+	completed  flows.CategoryUUID
+	incomplete flows.CategoryUUID
+}
+
+// NewForm creates a new form router
+func NewForm(wait *waits.FormWait, resultName string, categories []flows.Category, completed, incomplete flows.CategoryUUID) *FormRouter {
+	return &FormRouter{
+		baseRouter: newBaseRouter(TypeForm, wait, resultName, categories),
+		completed:  completed,
+		incomplete: incomplete,
+	}
+}
+
+// Validate validates the arguments for this router
+func (r *FormRouter) Validate(exits []flows.Exit) error {
+	if _, isForm := r.wait.(*waits.FormWait); !isForm {
+		return errors.New("form routers must have a form wait")
+	}
+
+	// check each category is valid
+	for _, c := range []flows.CategoryUUID{r.completed, r.incomplete} {
+		if !r.isValidCategory(c) {
+			return errors.Errorf("category %s is not a valid category", c)
+		}
+	}
+
+	return r.validate(exits)
+}
+
+// Route determines which exit to take from a node
+func (r *FormRouter) Route(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) (flows.ExitUUID, error) {
+	fields := r.wait.(*waits.FormWait).Fields()
+	answered := 0
+
+	if form, isForm := run.Session().Input().(*inputs.FormInput); isForm {
+		for _, field := range fields {
+			answer, hasAnswer := form.Answers()[field.Key]
+			if !hasAnswer {
+				continue
+			}
+
+			value, err := field.Parse(run.Environment(), answer)
+			if err != nil {
+				run.LogError(step, err)
+				continue
+			}
+
+			result := flows.NewResult(field.ResultName, types.Render(value), "", "", step.NodeUUID(), string(answer), nil, dates.Now())
+			run.SaveResult(result)
+			logEvent(events.NewRunResultChanged(result))
+			answered++
+		}
+	}
+
+	categoryUUID := r.incomplete
+	if answered == len(fields) {
+		categoryUUID = r.completed
+	}
+
+	extra := types.NewXObject(map[string]types.XValue{"answered": types.NewXNumberFromInt(answered)})
+
+	return r.routeToCategory(run, step, categoryUUID, strconv.Itoa(answered), "", extra, logEvent)
+}
+
+// EnumerateResults enumerates all potential results on this object
+func (r *FormRouter) EnumerateResults(include func(*flows.ResultInfo)) {
+	if wait, isForm := r.wait.(*waits.FormWait); isForm {
+		for _, field := range wait.Fields() {
+			include(flows.NewResultInfo(field.ResultName, nil))
+		}
+	}
+
+	r.baseRouter.EnumerateResults(include)
+}
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type formRouterEnvelope struct {
+	baseRouterEnvelope
+
+	Completed  flows.CategoryUUID `json:"completed_category_uuid"  validate:"required,uuid4"`
+	Incomplete flows.CategoryUUID `json:"incomplete_category_uuid" validate:"required,uuid4"`
+}
+
+func readFormRouter(data json.RawMessage) (flows.Router, error) {
+	e := &formRouterEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &FormRouter{
+		completed:  e.Completed,
+		incomplete: e.Incomplete,
+	}
+
+	if err := r.unmarshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this router into JSON
+func (r *FormRouter) MarshalJSON() ([]byte, error) {
+	e := &formRouterEnvelope{
+		Completed:  r.completed,
+		Incomplete: r.incomplete,
+	}
+
+	if err := r.marshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
@@ -0,0 +1,222 @@
+package routers
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/inspect"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+	"github.com/nyaruka/goflow/utils/uuids"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeMenu, readMenuRouter)
+}
+
+// TypeMenu is the constant for our menu router
+const TypeMenu string = "menu"
+
+// Retry describes the message a menu router sends to re-prompt the contact when their answer doesn't match
+// any case, and how many times it will do so
+type Retry struct {
+	UUID       uuids.UUID `json:"uuid"        validate:"required,uuid4"`
+	Text       string     `json:"text"        validate:"required" engine:"localized,evaluated"`
+	MaxRetries int        `json:"max_retries" validate:"min=1"`
+}
+
+// NewRetry creates a new retry
+func NewRetry(uuid uuids.UUID, text string, maxRetries int) *Retry {
+	return &Retry{UUID: uuid, Text: text, MaxRetries: maxRetries}
+}
+
+// LocalizationUUID gets the UUID which identifies this object for localization
+func (r *Retry) LocalizationUUID() uuids.UUID { return r.UUID }
+
+// MenuRouter is a switch router which waits for an answer, and if that answer doesn't match any of its cases,
+// re-prompts the contact and waits again. Once the maximum number of retries has been reached, it exits through
+// its exhausted category. The number of retries used is saved in the extra of the result.
+type MenuRouter struct {
+	SwitchRouter
+
+	retry     *Retry
+	exhausted flows.CategoryUUID
+}
+
+// NewMenu creates a new menu router
+func NewMenu(wait flows.Wait, resultName string, categories []flows.Category, operand string, cases []*Case, retry *Retry, exhaustedCategory flows.CategoryUUID) *MenuRouter {
+	return &MenuRouter{
+		SwitchRouter: SwitchRouter{
+			baseRouter: newBaseRouter(TypeMenu, wait, resultName, categories),
+			operand:    operand,
+			cases:      cases,
+		},
+		retry:     retry,
+		exhausted: exhaustedCategory,
+	}
+}
+
+// Retry returns the retry configuration of this router (optional)
+func (r *MenuRouter) Retry() *Retry { return r.retry }
+
+// Validate validates the arguments for this router
+func (r *MenuRouter) Validate(exits []flows.Exit) error {
+	// check the exhausted category is valid
+	if !r.isValidCategory(r.exhausted) {
+		return errors.Errorf("exhausted category %s is not a valid category", r.exhausted)
+	}
+
+	return r.SwitchRouter.Validate(exits)
+}
+
+// Route determines which exit to take from a node, and if no case matches, takes the exhausted category
+func (r *MenuRouter) Route(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) (flows.ExitUUID, error) {
+	exitUUID, _, err := r.route(run, step, nil, logEvent)
+	return exitUUID, err
+}
+
+// RouteOrRetry determines which exit to take from a node, or if no case matches and we still have retries left,
+// sends the retry message and waits again
+func (r *MenuRouter) RouteOrRetry(run flows.FlowRun, step flows.Step, waitAgain func() bool, logEvent flows.EventCallback) (flows.ExitUUID, bool, error) {
+	return r.route(run, step, waitAgain, logEvent)
+}
+
+func (r *MenuRouter) route(run flows.FlowRun, step flows.Step, waitAgain func() bool, logEvent flows.EventCallback) (flows.ExitUUID, bool, error) {
+	_, input, match, categoryUUID, extra, err := r.evaluateCases(run, step, logEvent)
+	if err != nil {
+		return "", false, err
+	}
+
+	// none of our cases matched, so either re-prompt the contact or give up
+	if categoryUUID == "" {
+		if waitAgain != nil && r.wait != nil && r.retry != nil && step.Retries() < r.retry.MaxRetries {
+			r.sendRetry(run, step, logEvent)
+
+			if waitAgain() {
+				step.Retry()
+				return "", true, nil
+			}
+		}
+
+		match = input
+		categoryUUID = r.exhausted
+	}
+
+	exitUUID, err := r.routeToCategory(run, step, categoryUUID, match, input, withRetries(extra, step.Retries()), logEvent)
+	return exitUUID, false, err
+}
+
+// sends the retry message to the contact
+func (r *MenuRouter) sendRetry(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) {
+	localizedText := run.GetText(r.retry.UUID, "text", r.retry.Text)
+	evaluatedText, err := run.EvaluateTemplate(localizedText)
+	if err != nil {
+		run.LogError(step, err)
+	}
+
+	var destinations []flows.Destination
+	if run.Contact() != nil {
+		destinations = run.Contact().ResolveDestinations(false)
+	}
+
+	msgs := flows.NewMsgsOutForDestinations(destinations, func(urn urns.URN, channel *assets.ChannelReference) *flows.MsgOut {
+		return flows.NewMsgOut(urn, channel, evaluatedText, nil, nil, nil, flows.NilMsgTopic, step.WantsResponse())
+	})
+
+	for _, msg := range msgs {
+		logEvent(events.NewMsgCreated(msg))
+	}
+}
+
+// adds the retry count to the extra returned by a case test
+func withRetries(extra *types.XObject, retries int) *types.XObject {
+	properties := make(map[string]types.XValue)
+	if extra != nil {
+		for _, key := range extra.Properties() {
+			properties[key], _ = extra.Get(key)
+		}
+	}
+	properties["retries"] = types.NewXNumberFromInt(retries)
+
+	return types.NewXObject(properties)
+}
+
+// EnumerateTemplates enumerates all expressions on this object and its children
+func (r *MenuRouter) EnumerateTemplates(localization flows.Localization, include func(envs.Language, string)) {
+	r.SwitchRouter.EnumerateTemplates(localization, include)
+
+	if r.retry != nil {
+		inspect.Templates(r.retry, localization, include)
+	}
+}
+
+// EnumerateLocalizables enumerates all the localizable text on this object
+func (r *MenuRouter) EnumerateLocalizables(include func(uuids.UUID, string, []string, func([]string))) {
+	inspect.LocalizableText(r.cases, include)
+
+	if r.retry != nil {
+		inspect.LocalizableText(r.retry, include)
+	}
+
+	r.baseRouter.EnumerateLocalizables(include)
+}
+
+var _ flows.RetryingRouter = (*MenuRouter)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type menuRouterEnvelope struct {
+	baseRouterEnvelope
+
+	Operand   string             `json:"operand"                 validate:"required"`
+	Cases     []*Case            `json:"cases"`
+	Retry     *Retry             `json:"retry,omitempty"         validate:"omitempty"`
+	Exhausted flows.CategoryUUID `json:"exhausted_category_uuid" validate:"required,uuid4"`
+}
+
+func readMenuRouter(data json.RawMessage) (flows.Router, error) {
+	e := &menuRouterEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	r := &MenuRouter{
+		SwitchRouter: SwitchRouter{
+			operand: e.Operand,
+			cases:   e.Cases,
+		},
+		retry:     e.Retry,
+		exhausted: e.Exhausted,
+	}
+
+	if err := r.unmarshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MarshalJSON marshals this router into JSON
+func (r *MenuRouter) MarshalJSON() ([]byte, error) {
+	e := &menuRouterEnvelope{
+		Operand:   r.operand,
+		Cases:     r.cases,
+		Retry:     r.retry,
+		Exhausted: r.exhausted,
+	}
+
+	if err := r.marshal(&e.baseRouterEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
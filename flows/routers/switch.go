@@ -9,6 +9,7 @@ import (
 	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
 	"github.com/nyaruka/goflow/flows/inspect"
 	"github.com/nyaruka/goflow/flows/routers/cases"
 	"github.com/nyaruka/goflow/utils"
@@ -116,6 +117,29 @@ func (r *SwitchRouter) Validate(exits []flows.Exit) error {
 
 // Route determines which exit to take from a node
 func (r *SwitchRouter) Route(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) (flows.ExitUUID, error) {
+	operand, input, match, categoryUUID, extra, err := r.evaluateCases(run, step, logEvent)
+	if err != nil {
+		return "", err
+	}
+
+	// none of our cases matched, so try to use the default
+	if categoryUUID == "" && r.default_ != "" {
+		// evaluate our operand as a string
+		value, xerr := types.ToXText(run.Environment(), operand)
+		if xerr != nil {
+			run.LogError(step, xerr)
+		}
+
+		match = value.Native()
+		categoryUUID = r.default_
+	}
+
+	return r.routeToCategory(run, step, categoryUUID, match, input, extra, logEvent)
+}
+
+// evaluates our operand and then our cases against it, returning the operand, the input, and the match, category
+// and extra of the first matching case
+func (r *SwitchRouter) evaluateCases(run flows.FlowRun, step flows.Step, logEvent flows.EventCallback) (types.XValue, string, string, flows.CategoryUUID, *types.XObject, error) {
 	env := run.Environment()
 
 	// first evaluate our operand
@@ -131,28 +155,34 @@ func (r *SwitchRouter) Route(run flows.FlowRun, step flows.Step, logEvent flows.
 		input = asText.Native()
 	}
 
-	// find first matching case
-	match, categoryUUID, extra, err := r.matchCase(run, step, operand)
-	if err != nil {
-		return "", err
+	// if case tracing is enabled, record the result of each case we evaluate
+	var traced []*events.CaseResult
+	var trace caseTracer
+	if run.Session().Engine().CaseTracing() {
+		trace = func(c *Case, args []types.XValue, result types.XValue) {
+			traced = append(traced, newCaseResult(env, c, args, result))
+		}
 	}
 
-	// none of our cases matched, so try to use the default
-	if categoryUUID == "" && r.default_ != "" {
-		// evaluate our operand as a string
-		value, xerr := types.ToXText(env, operand)
-		if xerr != nil {
-			run.LogError(step, xerr)
-		}
+	// find first matching case
+	match, categoryUUID, extra, err := r.matchCase(run, step, operand, trace)
 
-		match = value.Native()
-		categoryUUID = r.default_
+	// log the traced cases even if matching failed, as that's when they're most useful
+	if trace != nil {
+		logEvent(events.NewCasesEvaluated(input, traced))
 	}
 
-	return r.routeToCategory(run, step, categoryUUID, match, input, extra, logEvent)
+	if err != nil {
+		return nil, "", "", "", nil, err
+	}
+
+	return operand, input, match, categoryUUID, extra, nil
 }
 
-func (r *SwitchRouter) matchCase(run flows.FlowRun, step flows.Step, operand types.XValue) (string, flows.CategoryUUID, *types.XObject, error) {
+// callback used to trace the evaluation of each case
+type caseTracer func(c *Case, args []types.XValue, result types.XValue)
+
+func (r *SwitchRouter) matchCase(run flows.FlowRun, step flows.Step, operand types.XValue, trace caseTracer) (string, flows.CategoryUUID, *types.XObject, error) {
 	for _, c := range r.cases {
 		test := strings.ToLower(c.Type)
 
@@ -178,6 +208,10 @@ func (r *SwitchRouter) matchCase(run flows.FlowRun, step flows.Step, operand typ
 		// call our function
 		result := xtest(run.Environment(), args...)
 
+		if trace != nil {
+			trace(c, args[1:], result)
+		}
+
 		// tests have to return either errors or test results
 		switch typed := result.(type) {
 		case types.XError:
@@ -210,6 +244,37 @@ func (r *SwitchRouter) matchCase(run flows.FlowRun, step flows.Step, operand typ
 	return "", "", nil, nil
 }
 
+// creates a case result for tracing from the evaluated arguments and result of a case test
+func newCaseResult(env envs.Environment, c *Case, args []types.XValue, result types.XValue) *events.CaseResult {
+	caseResult := &events.CaseResult{CaseUUID: c.UUID, Type: c.Type}
+
+	for _, arg := range args {
+		asText, _ := types.ToXText(env, arg)
+		caseResult.Arguments = append(caseResult.Arguments, asText.Native())
+	}
+
+	switch typed := result.(type) {
+	case types.XError:
+		caseResult.Error = typed.Error()
+	case *types.XObject:
+		caseResult.Matched = typed.Truthy()
+
+		if caseResult.Matched {
+			match, _ := typed.Get("match")
+			extra, _ := typed.Get("extra")
+
+			value, _ := types.ToXText(env, match)
+			caseResult.Value = value.Native()
+
+			if extra != nil {
+				caseResult.Extra, _ = jsonx.Marshal(extra)
+			}
+		}
+	}
+
+	return caseResult
+}
+
 // EnumerateTemplates enumerates all expressions on this object and its children
 func (r *SwitchRouter) EnumerateTemplates(localization flows.Localization, include func(envs.Language, string)) {
 	include(envs.NilLanguage, r.operand)
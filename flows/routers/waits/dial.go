@@ -0,0 +1,198 @@
+package waits
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/resumes"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeDial, readDialWait, readActivatedDialWait)
+}
+
+// TypeDial is the type of our dial wait
+const TypeDial string = "dial"
+
+// DialWait is a wait which waits for the caller in a voice flow to be connected to another number (i.e. a dial resume)
+type DialWait struct {
+	baseWait
+
+	phone            string
+	dialLimitSeconds int
+	callLimitSeconds int
+}
+
+// NewDialWait creates a new dial wait
+func NewDialWait(phone string, dialLimitSeconds int, callLimitSeconds int) *DialWait {
+	return &DialWait{
+		baseWait:         newBaseWait(TypeDial, nil),
+		phone:            phone,
+		dialLimitSeconds: dialLimitSeconds,
+		callLimitSeconds: callLimitSeconds,
+	}
+}
+
+// Phone returns the phone number expression of this wait
+func (w *DialWait) Phone() string { return w.phone }
+
+// DialLimitSeconds returns the number of seconds to wait for the dialed number to answer
+func (w *DialWait) DialLimitSeconds() int { return w.dialLimitSeconds }
+
+// CallLimitSeconds returns the maximum number of seconds the connected call can last
+func (w *DialWait) CallLimitSeconds() int { return w.callLimitSeconds }
+
+// Begin begins waiting at this wait
+func (w *DialWait) Begin(run flows.FlowRun, log flows.EventCallback) flows.ActivatedWait {
+	if run.Session().Type() != flows.FlowTypeVoice {
+		log(events.NewErrorf("dial waits can only be used in voice flows"))
+		return nil
+	}
+
+	phone, err := run.EvaluateTemplate(w.phone)
+	if err != nil {
+		log(events.NewError(err))
+	}
+
+	// if we can't get a valid tel URN from our phone number, we skip ourselves
+	country := string(run.Environment().DefaultCountry())
+	urn, err := urns.NewTelURNForCountry(phone, country)
+	if err != nil {
+		log(events.NewErrorf("'%s' is not a valid phone number", phone))
+		return nil
+	}
+
+	log(events.NewDialWait(urn, w.dialLimitSeconds, w.callLimitSeconds))
+
+	return NewActivatedDialWait(urn, w.dialLimitSeconds, w.callLimitSeconds)
+}
+
+// End ends this wait or returns an error
+func (w *DialWait) End(resume flows.Resume) error {
+	switch resume.Type() {
+	case resumes.TypeDial, resumes.TypeCallStatus:
+		return nil
+	case resumes.TypeMsg:
+		return errors.Errorf("can't end a dial wait with a resume of type '%s'", resume.Type())
+	}
+
+	return w.baseWait.End(resume)
+}
+
+var _ flows.Wait = (*DialWait)(nil)
+
+// ActivatedDialWait is a dial wait once it has been activated in a session
+type ActivatedDialWait struct {
+	baseActivatedWait
+
+	urn              urns.URN
+	dialLimitSeconds int
+	callLimitSeconds int
+}
+
+// NewActivatedDialWait creates a new activated dial wait
+func NewActivatedDialWait(urn urns.URN, dialLimitSeconds int, callLimitSeconds int) *ActivatedDialWait {
+	return &ActivatedDialWait{
+		baseActivatedWait: baseActivatedWait{type_: TypeDial},
+		urn:               urn,
+		dialLimitSeconds:  dialLimitSeconds,
+		callLimitSeconds:  callLimitSeconds,
+	}
+}
+
+// URN returns the URN to be dialed
+func (w *ActivatedDialWait) URN() urns.URN { return w.urn }
+
+// DialLimitSeconds returns the number of seconds to wait for the dialed number to answer
+func (w *ActivatedDialWait) DialLimitSeconds() int { return w.dialLimitSeconds }
+
+// CallLimitSeconds returns the maximum number of seconds the connected call can last
+func (w *ActivatedDialWait) CallLimitSeconds() int { return w.callLimitSeconds }
+
+var _ flows.ActivatedWait = (*ActivatedDialWait)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type dialWaitEnvelope struct {
+	baseWaitEnvelope
+
+	Phone            string `json:"phone"                        validate:"required"`
+	DialLimitSeconds int    `json:"dial_limit_seconds,omitempty" validate:"omitempty,min=1"`
+	CallLimitSeconds int    `json:"call_limit_seconds,omitempty" validate:"omitempty,min=1"`
+}
+
+func readDialWait(data json.RawMessage) (flows.Wait, error) {
+	e := &dialWaitEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	w := &DialWait{
+		phone:            e.Phone,
+		dialLimitSeconds: e.DialLimitSeconds,
+		callLimitSeconds: e.CallLimitSeconds,
+	}
+
+	return w, w.unmarshal(&e.baseWaitEnvelope)
+}
+
+// MarshalJSON marshals this wait into JSON
+func (w *DialWait) MarshalJSON() ([]byte, error) {
+	e := &dialWaitEnvelope{
+		Phone:            w.phone,
+		DialLimitSeconds: w.dialLimitSeconds,
+		CallLimitSeconds: w.callLimitSeconds,
+	}
+
+	if err := w.marshal(&e.baseWaitEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
+
+type activatedDialWaitEnvelope struct {
+	baseActivatedWaitEnvelope
+
+	URN              urns.URN `json:"urn"                          validate:"required,urn"`
+	DialLimitSeconds int      `json:"dial_limit_seconds,omitempty"`
+	CallLimitSeconds int      `json:"call_limit_seconds,omitempty"`
+}
+
+func readActivatedDialWait(data json.RawMessage) (flows.ActivatedWait, error) {
+	e := &activatedDialWaitEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	w := &ActivatedDialWait{
+		urn:              e.URN,
+		dialLimitSeconds: e.DialLimitSeconds,
+		callLimitSeconds: e.CallLimitSeconds,
+	}
+
+	return w, w.unmarshal(&e.baseActivatedWaitEnvelope)
+}
+
+// MarshalJSON marshals this wait into JSON
+func (w *ActivatedDialWait) MarshalJSON() ([]byte, error) {
+	e := &activatedDialWaitEnvelope{
+		URN:              w.urn,
+		DialLimitSeconds: w.dialLimitSeconds,
+		CallLimitSeconds: w.callLimitSeconds,
+	}
+
+	if err := w.marshal(&e.baseActivatedWaitEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
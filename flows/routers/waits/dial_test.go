@@ -0,0 +1,153 @@
+package waits_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/resumes"
+	"github.com/nyaruka/goflow/flows/routers/waits"
+	"github.com/nyaruka/goflow/flows/triggers"
+	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var dialFlowJSON = `{
+	"flows": [
+		{
+			"uuid": "615b8a0f-588c-4d20-a05f-363b0b4ce6f4",
+			"name": "Hotline",
+			"spec_version": "13.0",
+			"language": "eng",
+			"type": "voice",
+			"nodes": [
+				{
+					"uuid": "46d51f50-58de-49da-8d13-dadbf322685d",
+					"router": {
+						"type": "dial",
+						"wait": {
+							"type": "dial",
+							"phone": "@(\"0979 123 456\")",
+							"dial_limit_seconds": 60,
+							"call_limit_seconds": 7200
+						},
+						"result_name": "Forward",
+						"categories": [
+							{
+								"uuid": "c82e161f-fa2d-4e7d-a338-c27f6c349445",
+								"name": "Answered",
+								"exit_uuid": "598ae7a5-2f81-48f1-afac-595262514aa1"
+							},
+							{
+								"uuid": "7d4ae0d9-3b3c-4b48-8b74-0d1dc4de9e18",
+								"name": "No Answer",
+								"exit_uuid": "9f4e9d7c-5ce8-4a6d-b2a0-0c0c5d3c2e9a"
+							},
+							{
+								"uuid": "b4e2f1a6-2f3a-4d6e-9b1c-3b6c8f5d2a71",
+								"name": "Busy",
+								"exit_uuid": "9f4e9d7c-5ce8-4a6d-b2a0-0c0c5d3c2e9a"
+							},
+							{
+								"uuid": "e1c7f6d2-8a4b-4c3e-9f5d-6a2b1c0d9e8f",
+								"name": "Failed",
+								"exit_uuid": "9f4e9d7c-5ce8-4a6d-b2a0-0c0c5d3c2e9a"
+							}
+						],
+						"answered_category_uuid": "c82e161f-fa2d-4e7d-a338-c27f6c349445",
+						"no_answer_category_uuid": "7d4ae0d9-3b3c-4b48-8b74-0d1dc4de9e18",
+						"busy_category_uuid": "b4e2f1a6-2f3a-4d6e-9b1c-3b6c8f5d2a71",
+						"failed_category_uuid": "e1c7f6d2-8a4b-4c3e-9f5d-6a2b1c0d9e8f"
+					},
+					"exits": [
+						{
+							"uuid": "598ae7a5-2f81-48f1-afac-595262514aa1"
+						},
+						{
+							"uuid": "9f4e9d7c-5ce8-4a6d-b2a0-0c0c5d3c2e9a"
+						}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func TestDialWait(t *testing.T) {
+	wait := waits.NewDialWait("@fields.supervisor", 60, 7200)
+	marshaled, err := jsonx.Marshal(wait)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"dial","phone":"@fields.supervisor","dial_limit_seconds":60,"call_limit_seconds":7200}`, string(marshaled))
+
+	sa, err := test.CreateSessionAssets([]byte(dialFlowJSON), "")
+	require.NoError(t, err)
+
+	flow, err := sa.Flows().Get("615b8a0f-588c-4d20-a05f-363b0b4ce6f4")
+	require.NoError(t, err)
+
+	eng := test.NewEngine()
+	env := envs.NewBuilder().WithDefaultCountry(envs.Country("EC")).Build()
+	contact := flows.NewEmptyContact(sa, "Ben Haggerty", envs.Language("eng"), nil)
+	channel := assets.NewChannelReference("57f1078f-88aa-46f4-a59a-948a5739c03d", "Nexmo")
+	trigger := triggers.NewBuilder(env, flow.Reference(), contact).Manual().WithConnection(channel, urns.URN("tel:+12065551212")).Build()
+
+	session, sprint, err := eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	// session should be waiting for the number to be dialed
+	assert.Equal(t, flows.SessionStatusWaiting, session.Status())
+	assert.Equal(t, 1, len(sprint.Events()))
+	assert.Equal(t, "dial_wait", sprint.Events()[0].Type())
+
+	activatedWait := session.Wait().(*waits.ActivatedDialWait)
+	assert.Equal(t, urns.URN("tel:+593979123456"), activatedWait.URN())
+	assert.Equal(t, 60, activatedWait.DialLimitSeconds())
+	assert.Equal(t, 7200, activatedWait.CallLimitSeconds())
+
+	// can't resume with a message
+	msg := flows.NewMsgIn("", urns.URN("tel:+12065551212"), channel, "Hi there", nil)
+	sprint, err = session.Resume(resumes.NewMsg(nil, nil, msg))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusWaiting, session.Status())
+	assert.Equal(t, "error", sprint.Events()[0].Type())
+
+	// but can with the outcome of the dial
+	sprint, err = session.Resume(resumes.NewDial(nil, nil, flows.NewDial(flows.DialStatusBusy, 0)))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusCompleted, session.Status())
+	assert.Equal(t, "dial_ended", sprint.Events()[0].Type())
+
+	result := session.Runs()[0].Results().Get("forward")
+	assert.Equal(t, "busy", result.Value)
+	assert.Equal(t, "Busy", result.Category)
+	assert.Equal(t, `{"duration":0}`, string(result.Extra))
+
+	// a completed transfer is treated as an answered dial
+	session, _, err = eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	sprint, err = session.Resume(resumes.NewCallStatus(nil, nil, flows.ConnectionStatusTransferCompleted))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusCompleted, session.Status())
+	assert.Equal(t, "call_status_changed", sprint.Events()[0].Type())
+
+	result = session.Runs()[0].Results().Get("forward")
+	assert.Equal(t, "answered", result.Value)
+	assert.Equal(t, "Answered", result.Category)
+	assert.Nil(t, result.Extra)
+
+	// and hanging up interrupts the run
+	session, _, err = eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	_, err = session.Resume(resumes.NewCallStatus(nil, nil, flows.ConnectionStatusHangup))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusInterrupted, session.Status())
+	assert.Equal(t, flows.RunStatusInterrupted, session.Runs()[0].Status())
+	assert.Nil(t, session.Runs()[0].Results().Get("forward"))
+}
@@ -0,0 +1,154 @@
+package waits
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/resumes"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeForm, readFormWait, readActivatedFormWait)
+}
+
+// TypeForm is the type of our form wait
+const TypeForm string = "form"
+
+// FormWait is a wait which waits for the contact to submit a form with answers to the given fields
+type FormWait struct {
+	baseWait
+
+	fields []*flows.FormField
+}
+
+// NewFormWait creates a new form wait
+func NewFormWait(timeout *Timeout, fields []*flows.FormField) *FormWait {
+	return &FormWait{
+		baseWait: newBaseWait(TypeForm, timeout),
+		fields:   fields,
+	}
+}
+
+// Fields returns the fields which the form is expected to answer
+func (w *FormWait) Fields() []*flows.FormField { return w.fields }
+
+// Begin beings waiting at this wait
+func (w *FormWait) Begin(run flows.FlowRun, log flows.EventCallback) flows.ActivatedWait {
+	var timeoutSeconds *int
+
+	if w.timeout != nil {
+		seconds := w.timeout.Seconds()
+		timeoutSeconds = &seconds
+	}
+
+	log(events.NewFormWait(w.fields, timeoutSeconds))
+
+	return NewActivatedFormWait(timeoutSeconds, w.fields)
+}
+
+// End ends this wait or returns an error
+func (w *FormWait) End(resume flows.Resume) error {
+	switch resume.Type() {
+	case resumes.TypeForm:
+		return nil
+	case resumes.TypeMsg:
+		return errors.Errorf("can't end a form wait with a resume of type '%s'", resume.Type())
+	}
+
+	return w.baseWait.End(resume)
+}
+
+var _ flows.Wait = (*FormWait)(nil)
+
+// ActivatedFormWait is a form wait once it has been activated in a session
+type ActivatedFormWait struct {
+	baseActivatedWait
+
+	fields []*flows.FormField
+}
+
+// NewActivatedFormWait creates a new activated form wait
+func NewActivatedFormWait(timeoutSeconds *int, fields []*flows.FormField) *ActivatedFormWait {
+	return &ActivatedFormWait{
+		baseActivatedWait: baseActivatedWait{type_: TypeForm, timeoutSeconds: timeoutSeconds},
+		fields:            fields,
+	}
+}
+
+// Fields returns the fields which the form is expected to answer
+func (w *ActivatedFormWait) Fields() []*flows.FormField { return w.fields }
+
+var _ flows.ActivatedWait = (*ActivatedFormWait)(nil)
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type formWaitEnvelope struct {
+	baseWaitEnvelope
+
+	Fields []*flows.FormField `json:"fields" validate:"required,min=1,dive"`
+}
+
+func readFormWait(data json.RawMessage) (flows.Wait, error) {
+	e := &formWaitEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	w := &FormWait{
+		fields: e.Fields,
+	}
+
+	return w, w.unmarshal(&e.baseWaitEnvelope)
+}
+
+// MarshalJSON marshals this wait into JSON
+func (w *FormWait) MarshalJSON() ([]byte, error) {
+	e := &formWaitEnvelope{
+		Fields: w.fields,
+	}
+
+	if err := w.marshal(&e.baseWaitEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
+
+type activatedFormWaitEnvelope struct {
+	baseActivatedWaitEnvelope
+
+	Fields []*flows.FormField `json:"fields" validate:"required,min=1,dive"`
+}
+
+func readActivatedFormWait(data json.RawMessage) (flows.ActivatedWait, error) {
+	e := &activatedFormWaitEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	w := &ActivatedFormWait{
+		fields: e.Fields,
+	}
+
+	return w, w.unmarshal(&e.baseActivatedWaitEnvelope)
+}
+
+// MarshalJSON marshals this wait into JSON
+func (w *ActivatedFormWait) MarshalJSON() ([]byte, error) {
+	e := &activatedFormWaitEnvelope{
+		Fields: w.fields,
+	}
+
+	if err := w.marshal(&e.baseActivatedWaitEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
@@ -0,0 +1,141 @@
+package waits_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/events"
+	"github.com/nyaruka/goflow/flows/resumes"
+	"github.com/nyaruka/goflow/flows/routers/waits"
+	"github.com/nyaruka/goflow/flows/triggers"
+	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var formFlowJSON = `{
+	"flows": [
+		{
+			"uuid": "2b7d6e4a-1c3f-4e5a-9b8d-7f6e5d4c3b2a",
+			"name": "Signup Form",
+			"spec_version": "13.0",
+			"language": "eng",
+			"type": "messaging",
+			"nodes": [
+				{
+					"uuid": "3c8e7f5b-2d4a-4f6b-8c9e-0a1b2c3d4e5f",
+					"router": {
+						"type": "form",
+						"wait": {
+							"type": "form",
+							"fields": [
+								{"key": "name", "type": "text", "result_name": "Name"},
+								{"key": "age", "type": "number", "result_name": "Age"},
+								{"key": "subscribe", "type": "boolean", "result_name": "Subscribe"}
+							]
+						},
+						"result_name": "Signup",
+						"categories": [
+							{
+								"uuid": "4d9f8a6c-3e5b-4a7c-9d0f-1b2c3d4e5f6a",
+								"name": "Completed",
+								"exit_uuid": "5e0a9b7d-4f6c-4b8d-8e1a-2c3d4e5f6a7b"
+							},
+							{
+								"uuid": "6f1b0c8e-5a7d-4c9e-9f2b-3d4e5f6a7b8c",
+								"name": "Incomplete",
+								"exit_uuid": "7a2c1d9f-6b8e-4d0f-8a3c-4e5f6a7b8c9d"
+							}
+						],
+						"completed_category_uuid": "4d9f8a6c-3e5b-4a7c-9d0f-1b2c3d4e5f6a",
+						"incomplete_category_uuid": "6f1b0c8e-5a7d-4c9e-9f2b-3d4e5f6a7b8c"
+					},
+					"exits": [
+						{
+							"uuid": "5e0a9b7d-4f6c-4b8d-8e1a-2c3d4e5f6a7b"
+						},
+						{
+							"uuid": "7a2c1d9f-6b8e-4d0f-8a3c-4e5f6a7b8c9d"
+						}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func TestFormWait(t *testing.T) {
+	wait := waits.NewFormWait(nil, []*flows.FormField{flows.NewFormField("age", flows.FormFieldTypeNumber, "Age")})
+	marshaled, err := jsonx.Marshal(wait)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"form","fields":[{"key":"age","type":"number","result_name":"Age"}]}`, string(marshaled))
+
+	sa, err := test.CreateSessionAssets([]byte(formFlowJSON), "")
+	require.NoError(t, err)
+
+	flow, err := sa.Flows().Get("2b7d6e4a-1c3f-4e5a-9b8d-7f6e5d4c3b2a")
+	require.NoError(t, err)
+
+	eng := test.NewEngine()
+	env := envs.NewBuilder().Build()
+	contact := flows.NewEmptyContact(sa, "Ben Haggerty", envs.Language("eng"), nil)
+	trigger := triggers.NewBuilder(env, flow.Reference(), contact).Manual().Build()
+
+	session, sprint, err := eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	// session should be waiting for the form
+	assert.Equal(t, flows.SessionStatusWaiting, session.Status())
+	assert.Equal(t, 1, len(sprint.Events()))
+	assert.Equal(t, "form_wait", sprint.Events()[0].Type())
+	assert.Equal(t, 3, len(session.Wait().(*waits.ActivatedFormWait).Fields()))
+
+	// can't resume with a message
+	sprint, err = session.Resume(resumes.NewMsg(nil, nil, flows.NewMsgIn("", "tel:+12065551212", nil, "Bob", nil)))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusWaiting, session.Status())
+	assert.Equal(t, "error", sprint.Events()[0].Type())
+
+	// but can with a form submission, and each answer is saved as a result
+	form := flows.NewFormIn("9a5e2c8b-1d5f-4a5c-8f3e-0b6b1e4c2d7a", nil, map[string]json.RawMessage{
+		"name":      json.RawMessage(`"Bob"`),
+		"age":       json.RawMessage(`"32"`),
+		"subscribe": json.RawMessage(`true`),
+	})
+	sprint, err = session.Resume(resumes.NewForm(nil, nil, form))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusCompleted, session.Status())
+	assert.Equal(t, "form_received", sprint.Events()[0].Type())
+
+	results := session.Runs()[0].Results()
+	assert.Equal(t, "Bob", results.Get("name").Value)
+	assert.Equal(t, "32", results.Get("age").Value)
+	assert.Equal(t, "true", results.Get("subscribe").Value)
+	assert.Equal(t, "Completed", results.Get("signup").Category)
+	assert.Equal(t, `{"answered":3}`, string(results.Get("signup").Extra))
+
+	// answers which are missing or can't be parsed leave the form incomplete
+	session, _, err = eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+
+	form = flows.NewFormIn("b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e", nil, map[string]json.RawMessage{
+		"name": json.RawMessage(`"Bob"`),
+		"age":  json.RawMessage(`"old"`),
+	})
+	_, err = session.Resume(resumes.NewForm(nil, nil, form))
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusCompleted, session.Status())
+
+	results = session.Runs()[0].Results()
+	assert.Equal(t, "Bob", results.Get("name").Value)
+	assert.Nil(t, results.Get("age"))
+	assert.Equal(t, "Incomplete", results.Get("signup").Category)
+	assert.Equal(t, "1", results.Get("signup").Value)
+
+	runEvents := session.Runs()[0].Events()
+	assert.Equal(t, "answer for form field 'age' is not a valid number", runEvents[len(runEvents)-2].(*events.ErrorEvent).Text)
+}
@@ -232,7 +232,7 @@ func (r *flowRun) RootContext(env envs.Environment) map[string]types.XValue {
 	var urns, fields types.XValue
 	if r.Contact() != nil {
 		urns = flows.ContextFunc(env, r.Contact().URNs().MapContext)
-		fields = flows.Context(env, r.Contact().Fields())
+		fields = flows.ContextFunc(env, r.Contact().FieldsContext)
 	}
 
 	var child = newRelatedRunContext(r.Session().GetCurrentChild(r))
@@ -294,20 +294,28 @@ func (r *flowRun) Context(env envs.Environment) map[string]types.XValue {
 func (r *flowRun) EvaluateTemplateValue(template string) (types.XValue, error) {
 	context := types.NewXObject(r.RootContext(r.Environment()))
 
-	return excellent.EvaluateTemplateValue(r.Environment(), context, template)
+	return r.compileTemplate(template, context).EvaluateValue(r.Environment(), context)
 }
 
 // EvaluateTemplateText evaluates the given template as text in the context of this run
 func (r *flowRun) EvaluateTemplateText(template string, escaping excellent.Escaping, truncate bool) (string, error) {
 	context := types.NewXObject(r.RootContext(r.Environment()))
 
-	value, err := excellent.EvaluateTemplate(r.Environment(), context, template, escaping)
+	value, err := r.compileTemplate(template, context).Evaluate(r.Environment(), context, escaping)
 	if truncate {
 		value = utils.TruncateEllipsis(value, r.Session().Engine().MaxTemplateChars())
 	}
 	return value, err
 }
 
+// compiles the given template, reusing the engine's compiled version of it if it has one
+func (r *flowRun) compileTemplate(template string, context *types.XObject) *excellent.Template {
+	if cache := r.Session().Engine().TemplateCache(); cache != nil {
+		return cache.Compile(template, context.Properties())
+	}
+	return excellent.CompileTemplate(template, context.Properties())
+}
+
 // EvaluateTemplate is a convenience function for evaluating as text with no escaping
 func (r *flowRun) EvaluateTemplate(template string) (string, error) {
 	return r.EvaluateTemplateText(template, nil, true)
@@ -16,6 +16,7 @@ type step struct {
 	exitUUID  flows.ExitUUID
 	arrivedOn time.Time
 	wantsResponse bool
+	retries   int
 }
 
 func (s *step) WantsResponse() bool {
@@ -43,11 +44,17 @@ func (s *step) UUID() flows.StepUUID     { return s.stepUUID }
 func (s *step) NodeUUID() flows.NodeUUID { return s.nodeUUID }
 func (s *step) ExitUUID() flows.ExitUUID { return s.exitUUID }
 func (s *step) ArrivedOn() time.Time     { return s.arrivedOn }
+func (s *step) Retries() int             { return s.retries }
 
 func (s *step) Leave(exit flows.ExitUUID) {
 	s.exitUUID = exit
 }
 
+// Retry records that the node of this step re-prompted the contact and waited again
+func (s *step) Retry() {
+	s.retries++
+}
+
 // Context returns the properties available in expressions
 func (s *step) Context(env envs.Environment) map[string]types.XValue {
 	return map[string]types.XValue{
@@ -81,6 +88,7 @@ type stepEnvelope struct {
 	NodeUUID  flows.NodeUUID `json:"node_uuid" validate:"required,uuid4"`
 	ExitUUID  flows.ExitUUID `json:"exit_uuid,omitempty" validate:"omitempty,uuid4"`
 	ArrivedOn time.Time      `json:"arrived_on"`
+	Retries   int            `json:"retries,omitempty"`
 }
 
 // UnmarshalJSON unmarshals a run step from the given JSON
@@ -97,6 +105,7 @@ func (s *step) UnmarshalJSON(data []byte) error {
 	s.nodeUUID = se.NodeUUID
 	s.exitUUID = se.ExitUUID
 	s.arrivedOn = se.ArrivedOn
+	s.retries = se.Retries
 	return err
 }
 
@@ -107,5 +116,6 @@ func (s *step) MarshalJSON() ([]byte, error) {
 		NodeUUID:  s.nodeUUID,
 		ExitUUID:  s.exitUUID,
 		ArrivedOn: s.arrivedOn,
+		Retries:   s.retries,
 	})
 }
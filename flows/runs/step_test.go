@@ -44,4 +44,14 @@ func TestStep(t *testing.T) {
 	marshaled, err := jsonx.Marshal(step)
 	require.NoError(t, err)
 	test.AssertEqualJSON(t, []byte(`{"arrived_on":"2018-10-26T14:50:31.23456789Z","node_uuid":"5fb4f555-7662-4c4c-8387-226e359526e4","uuid":"c00e5d67-c275-4389-aded-7d8b151cbd5b"}`), marshaled, "JSON mismatch")
+
+	// retries are only included once the step has been retried
+	assert.Equal(t, 0, step.Retries())
+	step.Retry()
+	step.Retry()
+	assert.Equal(t, 2, step.Retries())
+
+	marshaled, err = jsonx.Marshal(step)
+	require.NoError(t, err)
+	test.AssertEqualJSON(t, []byte(`{"arrived_on":"2018-10-26T14:50:31.23456789Z","node_uuid":"5fb4f555-7662-4c4c-8387-226e359526e4","retries":2,"uuid":"c00e5d67-c275-4389-aded-7d8b151cbd5b"}`), marshaled, "JSON mismatch")
 }
@@ -70,7 +70,7 @@ func (c *relatedRunContext) Context(env envs.Environment) map[string]types.XValu
 	var urns, fields types.XValue
 	if c.run.Contact() != nil {
 		urns = flows.ContextFunc(env, c.run.Contact().URNs().MapContext)
-		fields = flows.Context(env, c.run.Contact().Fields())
+		fields = flows.ContextFunc(env, c.run.Contact().FieldsContext)
 	}
 
 	return map[string]types.XValue{
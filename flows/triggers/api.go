@@ -0,0 +1,157 @@
+package triggers
+
+import (
+	"encoding/json"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/excellent/types"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registerType(TypeAPI, readAPITrigger)
+}
+
+// TypeAPI is the type for sessions triggered by events in external systems
+const TypeAPI string = "api"
+
+// APITrigger is used when a session was triggered by an event in an external system. The payload is validated
+// against the payload schema of the flow if it has one.
+//
+//   {
+//     "type": "api",
+//     "flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"},
+//     "contact": {
+//       "uuid": "9f7ede93-4b16-4692-80ad-b7dc54a1cd81",
+//       "name": "Bob",
+//       "created_on": "2018-01-01T12:00:00.000000Z"
+//     },
+//     "event": "order.shipped",
+//     "source": "shopify",
+//     "payload": {"order_id": 1234, "status": "shipped"},
+//     "triggered_on": "2000-01-01T00:00:00.000000000-00:00"
+//   }
+//
+// @trigger api
+type APITrigger struct {
+	baseTrigger
+
+	event   string
+	source  string
+	payload json.RawMessage
+}
+
+// Event returns the name of the external event
+func (t *APITrigger) Event() string { return t.event }
+
+// Source returns the identifier of the external system which sent the event
+func (t *APITrigger) Source() string { return t.source }
+
+// Payload returns the JSON payload of the external event
+func (t *APITrigger) Payload() json.RawMessage { return t.payload }
+
+// Initialize initializes the session
+func (t *APITrigger) Initialize(session flows.Session, logEvent flows.EventCallback) error {
+	if err := t.baseTrigger.Initialize(session, logEvent); err != nil {
+		return err
+	}
+
+	flow, _ := session.Assets().Flows().Get(t.Flow().UUID)
+
+	if flow.PayloadSchema() != nil {
+		if err := flow.PayloadSchema().Validate(t.payload); err != nil {
+			return errors.Wrapf(err, "payload doesn't match schema of %s", t.Flow())
+		}
+	}
+	return nil
+}
+
+// Context for API triggers additionally exposes the payload
+func (t *APITrigger) Context(env envs.Environment) map[string]types.XValue {
+	c := t.context()
+	c.payload = types.JSONToXValue(t.payload)
+	return c.asMap()
+}
+
+var _ flows.Trigger = (*APITrigger)(nil)
+
+//------------------------------------------------------------------------------------------
+// Builder
+//------------------------------------------------------------------------------------------
+
+// APIBuilder is a builder for API type triggers
+type APIBuilder struct {
+	t *APITrigger
+}
+
+// API returns an API trigger builder
+func (b *Builder) API(event string, payload json.RawMessage) *APIBuilder {
+	return &APIBuilder{
+		t: &APITrigger{
+			baseTrigger: newBaseTrigger(TypeAPI, b.environment, b.flow, b.contact, nil, false, nil),
+			event:       event,
+			payload:     payload,
+		},
+	}
+}
+
+// WithSource sets the source (i.e. the external system) of the trigger
+func (b *APIBuilder) WithSource(source string) *APIBuilder {
+	b.t.source = source
+	return b
+}
+
+// Build builds the trigger
+func (b *APIBuilder) Build() *APITrigger {
+	return b.t
+}
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+type apiTriggerEnvelope struct {
+	baseTriggerEnvelope
+	Event   string          `json:"event"            validate:"required"`
+	Source  string          `json:"source,omitempty"`
+	Payload json.RawMessage `json:"payload"          validate:"required"`
+}
+
+func readAPITrigger(sessionAssets flows.SessionAssets, data json.RawMessage, missing assets.MissingCallback) (flows.Trigger, error) {
+	e := &apiTriggerEnvelope{}
+	if err := utils.UnmarshalAndValidate(data, e); err != nil {
+		return nil, err
+	}
+
+	t := &APITrigger{
+		event:   e.Event,
+		source:  e.Source,
+		payload: e.Payload,
+	}
+
+	if err := t.unmarshal(sessionAssets, &e.baseTriggerEnvelope, missing); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// MarshalJSON marshals this trigger into JSON
+func (t *APITrigger) MarshalJSON() ([]byte, error) {
+	e := &apiTriggerEnvelope{
+		Event:   t.event,
+		Source:  t.source,
+		Payload: t.payload,
+	}
+
+	if err := t.marshal(&e.baseTriggerEnvelope); err != nil {
+		return nil, err
+	}
+
+	return jsonx.Marshal(e)
+}
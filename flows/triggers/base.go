@@ -131,16 +131,27 @@ type Context struct {
 	keyword string
 	user    string
 	origin  string
+	payload types.XValue
+	event   types.XValue
 }
 
 func (c *Context) asMap() map[string]types.XValue {
-	return map[string]types.XValue{
+	m := map[string]types.XValue{
 		"type":    types.NewXText(c.type_),
 		"params":  c.params,
 		"keyword": types.NewXText(c.keyword),
 		"user":    types.NewXText(c.user),
 		"origin":  types.NewXText(c.origin),
 	}
+
+	// payload and event are only included for the API and channel triggers which have them
+	if c.payload != nil {
+		m["payload"] = c.payload
+	}
+	if c.event != nil {
+		m["event"] = c.event
+	}
+	return m
 }
 
 func (t *baseTrigger) context() *Context {
@@ -159,6 +170,8 @@ func (t *baseTrigger) context() *Context {
 //   keyword:text -> the keyword match if this is a keyword trigger
 //   user:text -> the user who started this session if this is a manual trigger
 //   origin:text -> the origin of this session if this is a manual trigger
+//   payload:any -> the payload of the external event if this is an API trigger
+//   event:any -> the channel event if this is a channel trigger
 //
 // @context trigger
 func (t *baseTrigger) Context(env envs.Environment) map[string]types.XValue {
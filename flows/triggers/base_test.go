@@ -192,6 +192,13 @@ func TestTriggerMarshaling(t *testing.T) {
 		trigger  flows.Trigger
 		snapshot string
 	}{
+		{
+			triggers.NewBuilder(env, flow, contact).
+				API("order.shipped", json.RawMessage(`{"order_id": 1234, "status": "shipped"}`)).
+				WithSource("shopify").
+				Build(),
+			"api",
+		},
 		{
 			triggers.NewBuilder(env, flow, contact).
 				Campaign(triggers.NewCampaignReference("8cd472c4-bb85-459a-8c9a-c04708af799e", "Reminders"), "8d339613-f0be-48b7-92ee-155f4c7576f8").
@@ -212,6 +219,20 @@ func TestTriggerMarshaling(t *testing.T) {
 				Build(),
 			"channel_new_conversation",
 		},
+		{
+			triggers.NewBuilder(env, flow, contact).
+				Channel(channel, triggers.ChannelEventTypeReferral).
+				WithReferral("ad_1234", "ads").
+				Build(),
+			"channel_referral",
+		},
+		{
+			triggers.NewBuilder(env, flow, contact).
+				Channel(channel, triggers.ChannelEventTypeCallEnded).
+				WithCallDuration(45).
+				Build(),
+			"channel_call_ended",
+		},
 		{
 			triggers.NewBuilder(env, flow, contact).
 				FlowAction(history, json.RawMessage(`{"uuid": "084e4bed-667c-425e-82f7-bdb625e6ec9e"}`)).
@@ -270,6 +291,35 @@ func TestTriggerMarshaling(t *testing.T) {
 		_, err = triggers.ReadTrigger(sa, triggerJSON, assets.PanicOnMissing)
 		assert.NoError(t, err, "error reading trigger: %s", string(triggerJSON))
 	}
+
+	// referral events can't start sessions without a referral
+	trigger := triggers.NewBuilder(env, flow, contact).Channel(channel, triggers.ChannelEventTypeReferral).Build()
+	_, _, err = eng.NewSession(sa, trigger)
+	assert.EqualError(t, err, "referral channel events must include a referral")
+}
+
+func TestAPITriggerPayloadValidation(t *testing.T) {
+	assetsJSON, err := ioutil.ReadFile("testdata/_assets.json")
+	require.NoError(t, err)
+
+	sa, err := test.CreateSessionAssets(assetsJSON, "")
+	require.NoError(t, err)
+
+	env := envs.NewBuilder().Build()
+	flow := assets.NewFlowReference("1b462ce8-983a-4393-b133-e15a0efdb70c", "Order Updates")
+	contact := flows.NewEmptyContact(sa, "Bob", envs.Language("eng"), nil)
+	eng := engine.NewBuilder().Build()
+
+	// payload which matches the flow's schema
+	trigger := triggers.NewBuilder(env, flow, contact).API("order.shipped", json.RawMessage(`{"order_id": 1234, "status": "shipped"}`)).Build()
+	session, _, err := eng.NewSession(sa, trigger)
+	require.NoError(t, err)
+	assert.Equal(t, flows.SessionStatusCompleted, session.Status())
+
+	// payload which doesn't
+	trigger = triggers.NewBuilder(env, flow, contact).API("order.shipped", json.RawMessage(`{"order_id": "1234", "status": "shipped"}`)).Build()
+	_, _, err = eng.NewSession(sa, trigger)
+	assert.EqualError(t, err, "payload doesn't match schema of flow[uuid=1b462ce8-983a-4393-b133-e15a0efdb70c,name=Order Updates]: $.order_id: expected integer but found string")
 }
 
 func TestReadTrigger(t *testing.T) {
@@ -5,10 +5,13 @@ import (
 
 	"github.com/nyaruka/gocommon/urns"
 	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
 	"github.com/nyaruka/goflow/excellent/types"
 	"github.com/nyaruka/goflow/flows"
 	"github.com/nyaruka/goflow/utils"
 	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
 )
 
 func init() {
@@ -25,12 +28,57 @@ type ChannelEventType string
 const (
 	ChannelEventTypeNewConversation ChannelEventType = "new_conversation"
 	ChannelEventTypeIncomingCall    ChannelEventType = "incoming_call"
+	ChannelEventTypeMissedCall      ChannelEventType = "missed_call"
+	ChannelEventTypeCallEnded       ChannelEventType = "call_ended"
+	ChannelEventTypeReferral        ChannelEventType = "referral"
+	ChannelEventTypeStopContact     ChannelEventType = "stop_contact"
 )
 
+// ChannelReferral describes the referral which brought the contact to the channel, e.g. a click-to-chat ad
+type ChannelReferral struct {
+	ReferrerID string `json:"referrer_id" validate:"required"`
+	Source     string `json:"source,omitempty"`
+}
+
+// ChannelCall describes the call for missed_call and call_ended events
+type ChannelCall struct {
+	Duration int `json:"duration"`
+}
+
 // ChannelEvent describes the specific event on the channel that triggered the session
 type ChannelEvent struct {
-	Type    ChannelEventType         `json:"type" validate:"required"`
-	Channel *assets.ChannelReference `json:"channel" validate:"required,dive"`
+	Type     ChannelEventType         `json:"type" validate:"required"`
+	Channel  *assets.ChannelReference `json:"channel" validate:"required,dive"`
+	Referral *ChannelReferral         `json:"referral,omitempty" validate:"omitempty,dive"`
+	Call     *ChannelCall             `json:"call,omitempty"`
+}
+
+// checks that any details required by the event type are present
+func (e *ChannelEvent) validate() error {
+	if e.Type == ChannelEventTypeReferral && e.Referral == nil {
+		return errors.New("referral channel events must include a referral")
+	}
+	return nil
+}
+
+// Context returns the properties of this event available in expressions
+func (e *ChannelEvent) Context() *types.XObject {
+	properties := map[string]types.XValue{
+		"type":    types.NewXText(string(e.Type)),
+		"channel": types.NewXText(e.Channel.Name),
+	}
+	if e.Referral != nil {
+		properties["referral"] = types.NewXObject(map[string]types.XValue{
+			"referrer_id": types.NewXText(e.Referral.ReferrerID),
+			"source":      types.NewXText(e.Referral.Source),
+		})
+	}
+	if e.Call != nil {
+		properties["call"] = types.NewXObject(map[string]types.XValue{
+			"duration": types.NewXNumberFromInt(e.Call.Duration),
+		})
+	}
+	return types.NewXObject(properties)
 }
 
 // ChannelTrigger is used when a session was triggered by a channel event
@@ -50,12 +98,36 @@ type ChannelEvent struct {
 //     "triggered_on": "2000-01-01T00:00:00.000000000-00:00"
 //   }
 //
+// Referral events include the referrer ID and source of the referral, e.g. the ad which the contact clicked on, and
+// missed_call and call_ended events include the duration of the call in seconds. These are available in expressions
+// as @trigger.event.referral and @trigger.event.call.
+//
 // @trigger channel
 type ChannelTrigger struct {
 	baseTrigger
 	event *ChannelEvent
 }
 
+// Event returns the channel event that triggered this session
+func (t *ChannelTrigger) Event() *ChannelEvent { return t.event }
+
+// Initialize initializes the session
+func (t *ChannelTrigger) Initialize(session flows.Session, logEvent flows.EventCallback) error {
+	// triggers can be built without the details their event type requires
+	if err := t.event.validate(); err != nil {
+		return err
+	}
+
+	return t.baseTrigger.Initialize(session, logEvent)
+}
+
+// Context for channel triggers additionally exposes the channel event
+func (t *ChannelTrigger) Context(env envs.Environment) map[string]types.XValue {
+	c := t.context()
+	c.event = t.event.Context()
+	return c.asMap()
+}
+
 var _ flows.Trigger = (*ChannelTrigger)(nil)
 
 //------------------------------------------------------------------------------------------
@@ -89,6 +161,18 @@ func (b *ChannelBuilder) WithParams(params *types.XObject) *ChannelBuilder {
 	return b
 }
 
+// WithReferral sets the referral details for a referral event
+func (b *ChannelBuilder) WithReferral(referrerID, source string) *ChannelBuilder {
+	b.t.event.Referral = &ChannelReferral{ReferrerID: referrerID, Source: source}
+	return b
+}
+
+// WithCallDuration sets the call duration in seconds for a missed_call or call_ended event
+func (b *ChannelBuilder) WithCallDuration(duration int) *ChannelBuilder {
+	b.t.event.Call = &ChannelCall{Duration: duration}
+	return b
+}
+
 // Build builds the trigger
 func (b *ChannelBuilder) Build() *ChannelTrigger {
 	return b.t
@@ -109,6 +193,10 @@ func readChannelTrigger(sessionAssets flows.SessionAssets, data json.RawMessage,
 		return nil, err
 	}
 
+	if err := e.Event.validate(); err != nil {
+		return nil, err
+	}
+
 	t := &ChannelTrigger{
 		event: e.Event,
 	}
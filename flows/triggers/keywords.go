@@ -0,0 +1,230 @@
+package triggers
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/utils"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/pkg/errors"
+)
+
+// KeywordType describes how a keyword should be matched against incoming messages
+type KeywordType string
+
+// the different types of keyword
+const (
+	KeywordTypeExact  KeywordType = "exact"
+	KeywordTypePrefix KeywordType = "prefix"
+	KeywordTypeRegex  KeywordType = "regex"
+)
+
+// Keyword is a definition of a keyword which should start a flow when it's matched by an incoming message
+//
+//   {
+//     "keyword": "join",
+//     "type": "prefix",
+//     "aliases": {"fra": ["rejoindre"], "spa": ["unirse", "entrar"]},
+//     "channel": {"uuid": "61602f3e-f603-4c70-8a8f-c477505bf4bf", "name": "Twilio"},
+//     "priority": 1,
+//     "flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"}
+//   }
+type Keyword struct {
+	Keyword  string                     `json:"keyword"            validate:"required"`
+	Type     KeywordType                `json:"type"               validate:"required,eq=exact|eq=prefix|eq=regex"`
+	Aliases  map[envs.Language][]string `json:"aliases,omitempty"`
+	Channel  *assets.ChannelReference   `json:"channel,omitempty"  validate:"omitempty,dive"`
+	Priority int                        `json:"priority,omitempty"`
+	Flow     *assets.FlowReference      `json:"flow"               validate:"required,dive"`
+}
+
+// NewKeyword creates a new keyword definition
+func NewKeyword(keyword string, type_ KeywordType, aliases map[envs.Language][]string, channel *assets.ChannelReference, priority int, flow *assets.FlowReference) *Keyword {
+	return &Keyword{Keyword: keyword, Type: type_, Aliases: aliases, Channel: channel, Priority: priority, Flow: flow}
+}
+
+// the variants of a keyword, i.e. the keyword itself and its aliases in the allowed languages
+func (k *Keyword) variants(languages []envs.Language) []string {
+	variants := []string{k.Keyword}
+
+	if len(languages) == 0 {
+		for _, aliases := range k.Aliases {
+			variants = append(variants, aliases...)
+		}
+		sort.Strings(variants[1:])
+	} else {
+		for _, lang := range languages {
+			variants = append(variants, k.Aliases[lang]...)
+		}
+	}
+	return variants
+}
+
+// KeywordRegistry matches incoming messages against a set of keyword definitions
+type KeywordRegistry struct {
+	keywords []*Keyword
+	regexes  map[*Keyword][]*regexp.Regexp
+}
+
+// NewKeywordRegistry creates a new keyword registry, returning an error if any regex keyword isn't valid
+func NewKeywordRegistry(keywords []*Keyword) (*KeywordRegistry, error) {
+	r := &KeywordRegistry{keywords: keywords, regexes: make(map[*Keyword][]*regexp.Regexp)}
+
+	for _, k := range keywords {
+		if k.Type != KeywordTypeRegex {
+			continue
+		}
+
+		for _, v := range k.variants(nil) {
+			regex, err := regexp.Compile(`(?i)` + v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid regex keyword '%s'", v)
+			}
+			r.regexes[k] = append(r.regexes[k], regex)
+		}
+	}
+
+	return r, nil
+}
+
+// Keywords returns the keyword definitions in this registry
+func (r *KeywordRegistry) Keywords() []*Keyword { return r.keywords }
+
+// Match finds the keyword which best matches the given message, and returns the flow it should start along with
+// the keyword match to build a msg trigger with. Aliases are only considered for the given languages, or for all
+// languages if none are given. If more than one keyword matches, the winner is the one with the highest priority,
+// then keywords scoped to the message's channel, then exact over prefix over regex matches, then definition order.
+func (r *KeywordRegistry) Match(msg *flows.MsgIn, languages ...envs.Language) (*assets.FlowReference, *KeywordMatch) {
+	var best *Keyword
+	var bestMatch *KeywordMatch
+
+	for _, k := range r.keywords {
+		if k.Channel != nil && (msg.Channel() == nil || msg.Channel().UUID != k.Channel.UUID) {
+			continue
+		}
+
+		match := r.matchKeyword(k, msg.Text(), languages)
+		if match != nil && (best == nil || r.outranks(k, best)) {
+			best = k
+			bestMatch = match
+		}
+	}
+
+	if best == nil {
+		return nil, nil
+	}
+	return best.Flow, bestMatch
+}
+
+// matches the given text against the given keyword
+func (r *KeywordRegistry) matchKeyword(k *Keyword, text string, languages []envs.Language) *KeywordMatch {
+	if k.Type == KeywordTypeRegex {
+		variants := k.variants(nil)
+
+		for i, regex := range r.regexes[k] {
+			if !isVariantAllowed(k, variants[i], languages) {
+				continue
+			}
+			// a regex can legitimately match empty text so check for a match location rather than matched text
+			if loc := regex.FindStringIndex(text); loc != nil {
+				match := NewKeywordMatch(KeywordMatchTypeRegex, variants[i])
+				match.Text = strings.TrimSpace(text[loc[0]:loc[1]])
+				return match
+			}
+		}
+		return nil
+	}
+
+	words := tokenizeKeyword(text)
+
+	for _, v := range k.variants(languages) {
+		keywordWords := tokenizeKeyword(v)
+		if len(keywordWords) == 0 || len(words) < len(keywordWords) {
+			continue
+		}
+
+		if !wordsEqual(words[:len(keywordWords)], keywordWords) {
+			continue
+		}
+
+		if len(words) == len(keywordWords) {
+			return NewKeywordMatch(KeywordMatchTypeOnlyWord, strings.Join(keywordWords, " "))
+		} else if k.Type == KeywordTypePrefix {
+			return NewKeywordMatch(KeywordMatchTypeFirstWord, strings.Join(keywordWords, " "))
+		}
+	}
+	return nil
+}
+
+// whether keyword a should win over keyword b
+func (r *KeywordRegistry) outranks(a, b *Keyword) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if (a.Channel != nil) != (b.Channel != nil) {
+		return a.Channel != nil
+	}
+	return keywordTypeRank[a.Type] < keywordTypeRank[b.Type]
+}
+
+var keywordTypeRank = map[KeywordType]int{KeywordTypeExact: 0, KeywordTypePrefix: 1, KeywordTypeRegex: 2}
+
+// whether the given variant of a keyword is the keyword itself or an alias in one of the given languages
+func isVariantAllowed(k *Keyword, variant string, languages []envs.Language) bool {
+	if len(languages) == 0 || variant == k.Keyword {
+		return true
+	}
+	for _, lang := range languages {
+		for _, alias := range k.Aliases[lang] {
+			if alias == variant {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splits the given text into lowercase words for keyword matching
+func tokenizeKeyword(text string) []string {
+	return utils.TokenizeString(strings.ToLower(text))
+}
+
+func wordsEqual(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//------------------------------------------------------------------------------------------
+// JSON Encoding / Decoding
+//------------------------------------------------------------------------------------------
+
+// ReadKeywordRegistry reads a keyword registry from the given JSON list of keyword definitions
+func ReadKeywordRegistry(data json.RawMessage) (*KeywordRegistry, error) {
+	var keywords []*Keyword
+	if err := jsonx.Unmarshal(data, &keywords); err != nil {
+		return nil, err
+	}
+
+	for i, k := range keywords {
+		if err := utils.Validate(k); err != nil {
+			return nil, errors.Wrapf(err, "invalid keyword[%d]", i)
+		}
+	}
+
+	return NewKeywordRegistry(keywords)
+}
+
+// MarshalJSON marshals this registry into JSON
+func (r *KeywordRegistry) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(r.keywords)
+}
@@ -0,0 +1,113 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/gocommon/urns"
+	"github.com/nyaruka/goflow/assets"
+	"github.com/nyaruka/goflow/envs"
+	"github.com/nyaruka/goflow/flows"
+	"github.com/nyaruka/goflow/flows/triggers"
+	"github.com/nyaruka/goflow/test"
+	"github.com/nyaruka/goflow/utils/jsonx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywordRegistry(t *testing.T) {
+	registry, err := triggers.ReadKeywordRegistry([]byte(`[
+		{
+			"keyword": "join",
+			"type": "prefix",
+			"aliases": {"fra": ["rejoindre"], "spa": ["unirse"]},
+			"flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"}
+		},
+		{
+			"keyword": "join",
+			"type": "prefix",
+			"channel": {"uuid": "61602f3e-f603-4c70-8a8f-c477505bf4bf", "name": "Twilio"},
+			"flow": {"uuid": "b7cf0d83-f1c9-411c-96fd-c511a4cfa86d", "name": "Twilio Registration"}
+		},
+		{
+			"keyword": "stop",
+			"type": "exact",
+			"flow": {"uuid": "bb5b9e29-2c10-4ef1-9b91-7ae5e9c4d2f4", "name": "Opt Out"}
+		},
+		{
+			"keyword": "stop now",
+			"type": "prefix",
+			"priority": 1,
+			"flow": {"uuid": "3c4f1e0b-9a9f-4e2c-8d5b-0f6b2c7a1e93", "name": "Urgent Opt Out"}
+		},
+		{
+			"keyword": "^order\\s+#?\\d+",
+			"type": "regex",
+			"flow": {"uuid": "4e5a3c2b-1d0f-4b8e-9c7a-6d5e4f3a2b1c", "name": "Order Status"}
+		},
+		{
+			"keyword": "^\\s*$",
+			"type": "regex",
+			"flow": {"uuid": "0c0b7a2e-7d3b-4f4a-9d1c-2a9f6b0e5c41", "name": "Empty Message"}
+		}
+	]`))
+	require.NoError(t, err)
+
+	twilio := assets.NewChannelReference("61602f3e-f603-4c70-8a8f-c477505bf4bf", "Twilio")
+	nexmo := assets.NewChannelReference("57f1078f-88aa-46f4-a59a-948a5739c03d", "Nexmo")
+
+	tcs := []struct {
+		text      string
+		channel   *assets.ChannelReference
+		languages []envs.Language
+		flow      string
+		match     *triggers.KeywordMatch
+	}{
+		{"JOIN", nexmo, nil, "Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeOnlyWord, "join")},
+		{"join the club", nexmo, nil, "Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeFirstWord, "join")},
+		{"join the club", twilio, nil, "Twilio Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeFirstWord, "join")},
+		{"rejoindre", nexmo, nil, "Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeOnlyWord, "rejoindre")},
+		{"rejoindre", nexmo, []envs.Language{"fra"}, "Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeOnlyWord, "rejoindre")},
+		{"rejoindre", nexmo, []envs.Language{"spa"}, "", nil},
+		{"Unirse!", nil, []envs.Language{"spa"}, "Registration", triggers.NewKeywordMatch(triggers.KeywordMatchTypeOnlyWord, "unirse")},
+		{"please join", nexmo, nil, "", nil},
+		{"stop", nexmo, nil, "Opt Out", triggers.NewKeywordMatch(triggers.KeywordMatchTypeOnlyWord, "stop")},
+		{"stop it", nexmo, nil, "", nil},
+		{"Stop now please", nexmo, nil, "Urgent Opt Out", triggers.NewKeywordMatch(triggers.KeywordMatchTypeFirstWord, "stop now")},
+		{"Order #1234 status?", nexmo, nil, "Order Status", &triggers.KeywordMatch{Type: triggers.KeywordMatchTypeRegex, Keyword: `^order\s+#?\d+`, Text: "Order #1234"}},
+		{"my order 1234", nexmo, nil, "", nil},
+		{"", nexmo, nil, "Empty Message", &triggers.KeywordMatch{Type: triggers.KeywordMatchTypeRegex, Keyword: `^\s*$`, Text: ""}},
+	}
+
+	for _, tc := range tcs {
+		msg := flows.NewMsgIn("", urns.URN("tel:+12065551212"), tc.channel, tc.text, nil)
+		flow, match := registry.Match(msg, tc.languages...)
+
+		if tc.flow == "" {
+			assert.Nil(t, flow, "unexpected flow for '%s'", tc.text)
+		} else if assert.NotNil(t, flow, "expected flow for '%s'", tc.text) {
+			assert.Equal(t, tc.flow, flow.Name, "flow mismatch for '%s'", tc.text)
+		}
+		assert.Equal(t, tc.match, match, "keyword match mismatch for '%s'", tc.text)
+	}
+
+	// check we can marshal back to JSON
+	marshaled, err := jsonx.Marshal(registry)
+	require.NoError(t, err)
+	test.AssertEqualJSON(t, []byte(`[
+		{"keyword": "join", "type": "prefix", "aliases": {"fra": ["rejoindre"], "spa": ["unirse"]}, "flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"}},
+		{"keyword": "join", "type": "prefix", "channel": {"uuid": "61602f3e-f603-4c70-8a8f-c477505bf4bf", "name": "Twilio"}, "flow": {"uuid": "b7cf0d83-f1c9-411c-96fd-c511a4cfa86d", "name": "Twilio Registration"}},
+		{"keyword": "stop", "type": "exact", "flow": {"uuid": "bb5b9e29-2c10-4ef1-9b91-7ae5e9c4d2f4", "name": "Opt Out"}},
+		{"keyword": "stop now", "type": "prefix", "priority": 1, "flow": {"uuid": "3c4f1e0b-9a9f-4e2c-8d5b-0f6b2c7a1e93", "name": "Urgent Opt Out"}},
+		{"keyword": "^order\\s+#?\\d+", "type": "regex", "flow": {"uuid": "4e5a3c2b-1d0f-4b8e-9c7a-6d5e4f3a2b1c", "name": "Order Status"}},
+		{"keyword": "^\\s*$", "type": "regex", "flow": {"uuid": "0c0b7a2e-7d3b-4f4a-9d1c-2a9f6b0e5c41", "name": "Empty Message"}}
+	]`), marshaled, "marshal mismatch")
+
+	// error if keyword type isn't valid
+	_, err = triggers.ReadKeywordRegistry([]byte(`[{"keyword": "join", "type": "xxx", "flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"}}]`))
+	assert.EqualError(t, err, "invalid keyword[0]: field 'type' failed tag 'eq=exact|eq=prefix|eq=regex'")
+
+	// error if regex isn't valid
+	_, err = triggers.ReadKeywordRegistry([]byte(`[{"keyword": "(join", "type": "regex", "flow": {"uuid": "50c3706e-fedb-42c0-8eab-dda3335714b7", "name": "Registration"}}]`))
+	assert.EqualError(t, err, "invalid regex keyword '(join': error parsing regexp: missing closing ): `(?i)(join`")
+}
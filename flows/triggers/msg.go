@@ -58,12 +58,15 @@ type KeywordMatchType string
 const (
 	KeywordMatchTypeFirstWord KeywordMatchType = "first_word"
 	KeywordMatchTypeOnlyWord  KeywordMatchType = "only_word"
+	KeywordMatchTypeRegex     KeywordMatchType = "regex"
 )
 
-// KeywordMatch describes why the message triggered a session
+// KeywordMatch describes why the message triggered a session. For regex matches, the text which matched the regex
+// is also included.
 type KeywordMatch struct {
 	Type    KeywordMatchType `json:"type" validate:"required"`
 	Keyword string           `json:"keyword" validate:"required"`
+	Text    string           `json:"text,omitempty"`
 }
 
 // NewKeywordMatch creates a new keyword match
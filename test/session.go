@@ -242,12 +242,15 @@ var sessionAssets = `{
                 {
                     "name": "Kigali City",
                     "aliases": ["Kigali", "Kigari"],
+                    "boundary": [[[29.95, -2.1], [30.25, -2.1], [30.25, -1.8], [29.95, -1.8], [29.95, -2.1]]],
                     "children": [
                         {
                             "name": "Gasabo",
+                            "boundary": [[[30.05, -2.0], [30.25, -2.0], [30.25, -1.8], [30.05, -1.8], [30.05, -2.0]]],
                             "children": [
                                 {
-                                    "name": "Gisozi"
+                                    "name": "Gisozi",
+                                    "boundary": [[[30.05, -1.93], [30.1, -1.93], [30.1, -1.9], [30.05, -1.9], [30.05, -1.93]]]
                                 },
                                 {
                                     "name": "Ndera"
@@ -256,6 +259,7 @@ var sessionAssets = `{
                         },
                         {
                             "name": "Nyarugenge",
+                            "boundary": [[[29.95, -2.0], [30.05, -2.0], [30.05, -1.9], [29.95, -1.9], [29.95, -2.0]]],
                             "children": []
                         }
                     ]
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"io/ioutil"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/nyaruka/goflow/utils/jsonx"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMarshaling(t *testing.T) {
@@ -84,3 +87,87 @@ func TestDecodeGeneric(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"foo": json.Number("123")}, asSlice[0])
 	assert.Equal(t, map[string]interface{}{"foo": json.Number("456")}, asSlice[1])
 }
+
+func TestSchema(t *testing.T) {
+	_, err := jsonx.ReadSchema([]byte(`{"type": "foo"}`))
+	assert.EqualError(t, err, "$: 'foo' is not a supported schema type")
+
+	_, err = jsonx.ReadSchema([]byte(`{"type": "object", "properties": {"tags": {"type": "array", "items": {"type": "thing"}}}}`))
+	assert.EqualError(t, err, "$.tags[*]: 'thing' is not a supported schema type")
+
+	schema, err := jsonx.ReadSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"order_id": {"type": "integer"},
+			"total": {"type": "number"},
+			"status": {"type": "string", "enum": ["pending", "shipped"]},
+			"items": {"type": "array", "items": {"type": "object", "required": ["sku"]}}
+		},
+		"required": ["order_id", "status"]
+	}`))
+	require.NoError(t, err)
+
+	tcs := []struct {
+		data string
+		err  string
+	}{
+		{`{"order_id": 123, "total": 12, "status": "pending", "items": [{"sku": "A1"}]}`, ""},
+		{`{"order_id": 123, "total": 12.5, "status": "shipped", "extra": true}`, ""},
+		{`[]`, "$: expected object but found array"},
+		{`{"status": "pending"}`, "$: missing required property 'order_id'"},
+		{`{"order_id": 12.5, "status": "pending"}`, "$.order_id: expected integer but found number"},
+		{`{"order_id": 12.0, "status": "pending"}`, "$.order_id: expected integer but found number"},
+		{`{"order_id": 1e3, "status": "pending"}`, "$.order_id: expected integer but found number"},
+		{`{"order_id": 123, "status": "lost"}`, "$.status: value is not one of the allowed values"},
+		{`{"order_id": 123, "status": "pending", "items": [{"sku": "A1"}, {}]}`, "$.items[1]: missing required property 'sku'"},
+		{`{"order_id": 123, "status": "pending", "total": "12"}`, "$.total: expected number but found string"},
+	}
+
+	for _, tc := range tcs {
+		err := schema.Validate([]byte(tc.data))
+		if tc.err == "" {
+			assert.NoError(t, err, "unexpected error validating %s", tc.data)
+		} else {
+			assert.EqualError(t, err, tc.err, "error mismatch validating %s", tc.data)
+		}
+	}
+}
+
+func TestSchemaFor(t *testing.T) {
+	type Address struct {
+		City string `json:"city" validate:"required"`
+	}
+	type Person struct {
+		Name      string            `json:"name" validate:"required,max=64"`
+		Age       int               `json:"age,omitempty"`
+		Height    float64           `json:"height"`
+		Tags      []string          `json:"tags"`
+		Extra     map[string]string `json:"extra,omitempty"`
+		Address   *Address          `json:"address"`
+		Born      time.Time         `json:"born"`
+		Data      json.RawMessage   `json:"data"`
+		Ignored   string            `json:"-"`
+		private   string
+		Untagged  bool
+		Interface interface{} `json:"interface"`
+	}
+
+	marshaled, err := jsonx.Marshal(jsonx.SchemaFor(reflect.TypeOf(Person{})))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"age": {"type": "integer"},
+			"height": {"type": "number"},
+			"tags": {"type": "array", "nullable": true, "items": {"type": "string"}},
+			"extra": {"type": "object", "nullable": true},
+			"address": {"type": "object", "nullable": true, "properties": {"city": {"type": "string"}}, "required": ["city"]},
+			"born": {"type": "string", "format": "date-time"},
+			"data": {},
+			"Untagged": {"type": "boolean"},
+			"interface": {}
+		},
+		"required": ["name"]
+	}`, string(marshaled))
+}
@@ -0,0 +1,255 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schema is a JSON schema which can be used to validate JSON documents. Only a subset of the JSON schema
+// specification is supported: type, properties, required, items and enum, as well as nullable from OpenAPI. Format
+// is informational only.
+type Schema struct {
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Nullable   bool               `json:"nullable,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Enum       []json.RawMessage  `json:"enum,omitempty"`
+
+	enum []interface{}
+}
+
+var schemaTypes = map[string]bool{"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true}
+
+// ReadSchema reads a schema from the given JSON
+func ReadSchema(data json.RawMessage) (*Schema, error) {
+	s := &Schema{}
+	if err := Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if err := s.prepare("$"); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// checks the schema is valid and decodes any enum values
+func (s *Schema) prepare(path string) error {
+	if s.Type != "" && !schemaTypes[s.Type] {
+		return errors.Errorf("%s: '%s' is not a supported schema type", path, s.Type)
+	}
+
+	s.enum = make([]interface{}, len(s.Enum))
+	for i, e := range s.Enum {
+		var err error
+		if s.enum[i], err = DecodeGeneric(e); err != nil {
+			return errors.Wrapf(err, "%s: invalid enum value", path)
+		}
+	}
+
+	for key, p := range s.Properties {
+		if err := p.prepare(path + "." + key); err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		return s.Items.prepare(path + "[*]")
+	}
+	return nil
+}
+
+// Validate validates the given JSON against this schema
+func (s *Schema) Validate(data json.RawMessage) error {
+	value, err := DecodeGeneric(data)
+	if err != nil {
+		return err
+	}
+	return s.validate("$", value)
+}
+
+func (s *Schema) validate(path string, value interface{}) error {
+	if value == nil && s.Nullable {
+		return nil
+	}
+
+	if s.Type != "" {
+		if actual := schemaTypeOf(value); actual != s.Type && !(s.Type == "number" && actual == "integer") {
+			return errors.Errorf("%s: expected %s but found %s", path, s.Type, actual)
+		}
+	}
+
+	if len(s.enum) > 0 {
+		found := false
+		for _, e := range s.enum {
+			if reflect.DeepEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for _, key := range s.Required {
+			if _, present := typed[key]; !present {
+				return errors.Errorf("%s: missing required property '%s'", path, key)
+			}
+		}
+
+		// validate properties in a consistent order
+		keys := make([]string, 0, len(s.Properties))
+		for key := range s.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if v, present := typed[key]; present {
+				if err := s.Properties[key].validate(path+"."+key, v); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, v := range typed {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// gets the schema type of a generically decoded JSON value
+func schemaTypeOf(value interface{}) string {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		// decide based on the literal text of the number so that values like 1.0 aren't accepted as integers
+		if strings.ContainsAny(typed.String(), ".eE") {
+			return "number"
+		}
+		return "integer"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// SchemaFor generates a schema for the given Go type from its JSON struct tags. Struct fields are required if their
+// validate tag includes required. Pointers, slices and maps are nullable. Structs which marshal themselves and
+// interfaces can be any value.
+func SchemaFor(t reflect.Type) *Schema {
+	return schemaFor(t, make(map[reflect.Type]bool))
+}
+
+func schemaFor(t reflect.Type, visiting map[reflect.Type]bool) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+
+	s := schemaForValue(t, visiting)
+	if s.Type != "" && (nullable || t.Kind() == reflect.Slice || t.Kind() == reflect.Map) {
+		s.Nullable = true
+	}
+	return s
+}
+
+func schemaForValue(t reflect.Type, visiting map[reflect.Type]bool) *Schema {
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if t == rawMessageType || visiting[t] {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaFor(t.Elem(), visiting)}
+	case reflect.Map:
+		return &Schema{Type: "object"}
+	case reflect.Struct:
+		if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+			return &Schema{}
+		}
+
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		addStructProperties(s, t, visiting)
+		return s
+	}
+
+	return &Schema{}
+}
+
+// adds the properties of the given struct type to the given schema, flattening any embedded structs
+func addStructProperties(s *Schema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		jsonTag := strings.Split(field.Tag.Get("json"), ",")
+		name := jsonTag[0]
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				addStructProperties(s, fieldType, visiting)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s.Properties[name] = schemaFor(field.Type, visiting)
+
+		for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+			if rule == "required" {
+				s.Required = append(s.Required, name)
+				break
+			}
+		}
+	}
+}